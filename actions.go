@@ -0,0 +1,165 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"time"
+)
+
+// PendingDeployment is a deployment of a workflow run waiting for an environment approval
+type PendingDeployment struct {
+	Environment           *PendingDeploymentEnvironment `json:"environment,omitempty"`
+	WaitTimer             *int64                        `json:"wait_timer,omitempty"`
+	WaitTimerStartedAt    *github.Timestamp             `json:"wait_timer_started_at,omitempty"`
+	CurrentUserCanApprove *bool                         `json:"current_user_can_approve,omitempty"`
+	Reviewers             []*PendingDeploymentReviewer  `json:"reviewers,omitempty"`
+}
+
+// PendingDeploymentEnvironment is the environment a PendingDeployment waits for
+type PendingDeploymentEnvironment struct {
+	ID      *int64  `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+// PendingDeploymentReviewer is who may approve a PendingDeployment, Reviewer holds a User or a Team as told by Type
+type PendingDeploymentReviewer struct {
+	Type     *string         `json:"type,omitempty"`
+	Reviewer json.RawMessage `json:"reviewer,omitempty"`
+}
+
+// WorkflowRunUsage returns the billable time per runner OS of the workflow run runID
+func (c *Client) WorkflowRunUsage(repoName string, runID int64) (*github.WorkflowRunUsage, error) {
+
+	usage, _, err := c.github.Actions.GetWorkflowRunUsageByID(c.ctx, c.Organization, repoName, runID)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return usage, nil
+}
+
+// runnerMultipliers are the rates GitHub bills a minute of each runner OS at against the included minutes
+var runnerMultipliers = map[string]int64{"UBUNTU": 1, "MACOS": 10, "WINDOWS": 2}
+
+// runBill is the billable time of a workflow run on one runner OS, go-github v32 does not model job_runs yet
+type runBill struct {
+	TotalMS int64 `json:"total_ms"`
+	JobRuns []struct {
+		JobID      int64 `json:"job_id"`
+		DurationMS int64 `json:"duration_ms"`
+	} `json:"job_runs"`
+}
+
+// WorkflowCost sums the billed minutes of the runs of workflowID created since, keyed by runner OS
+// ("UBUNTU", "MACOS", "WINDOWS"). As GitHub bills them every job is rounded up to the whole minute and
+// multiplied by the rate of its runner OS, see runnerMultipliers
+func (c *Client) WorkflowCost(repoName string, workflowID int64, since time.Time) (map[string]int64, error) {
+	//
+	opts := &github.ListWorkflowRunsOptions{ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	cost := make(map[string]int64)
+	for {
+		runs, response, err := c.github.Actions.ListWorkflowRunsByID(c.ctx, c.Organization, repoName, workflowID, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		// runs are listed newest first, stop at the first one older than since
+		for _, run := range runs.WorkflowRuns {
+			if run.GetCreatedAt().Before(since) {
+				return cost, nil
+			}
+
+			var usage struct {
+				Billable map[string]runBill `json:"billable"`
+			}
+			u := fmt.Sprintf("repos/%s/%s/actions/runs/%d/timing", c.Organization, repoName, run.GetID())
+			if _, err = c.do("GET", u, nil, &usage); err != nil {
+				return nil, notFound(err)
+			}
+			for runnerOS, bill := range usage.Billable {
+				if minutes := billedMinutes(runnerOS, bill); minutes > 0 {
+					cost[runnerOS] += minutes
+				}
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return cost, nil
+}
+
+// billedMinutes returns the minutes bill is billed on runnerOS, every job rounded up to the whole minute. Without
+// the jobs detail the total is rounded up instead
+func billedMinutes(runnerOS string, bill runBill) int64 {
+
+	const minute = int64(time.Minute / time.Millisecond)
+	roundUp := func(ms int64) int64 {
+		return (ms + minute - 1) / minute
+	}
+
+	var minutes int64
+	if len(bill.JobRuns) == 0 {
+		minutes = roundUp(bill.TotalMS)
+	}
+	for _, job := range bill.JobRuns {
+		minutes += roundUp(job.DurationMS)
+	}
+
+	multiplier, ok := runnerMultipliers[runnerOS]
+	if !ok {
+		multiplier = 1
+	}
+	return minutes * multiplier
+}
+
+// PendingDeployments returns the deployments of the workflow run runID waiting for approval, empty when none waits
+func (c *Client) PendingDeployments(repoName string, runID int64) ([]*PendingDeployment, error) {
+
+	deployments := make([]*PendingDeployment, 0)
+	u := fmt.Sprintf("repos/%s/%s/actions/runs/%d/pending_deployments", c.Organization, repoName, runID)
+	if _, err := c.do("GET", u, nil, &deployments); err != nil {
+		return nil, notFound(err)
+	}
+	return deployments, nil
+}
+
+// ApproveDeployment approves the deployments of the workflow run runID waiting on the environments envIDs
+func (c *Client) ApproveDeployment(repoName string, runID int64, envIDs []int64, comment string) error {
+
+	if len(envIDs) == 0 {
+		return fmt.Errorf("envIDs cannot be null nor empty")
+	}
+
+	review := struct {
+		EnvironmentIDs []int64 `json:"environment_ids"`
+		State          string  `json:"state"`
+		Comment        string  `json:"comment"`
+	}{EnvironmentIDs: envIDs, State: "approved", Comment: comment}
+
+	u := fmt.Sprintf("repos/%s/%s/actions/runs/%d/pending_deployments", c.Organization, repoName, runID)
+	_, err := c.do("POST", u, review, nil)
+	return notFound(err)
+}
+
+// RerunFailedJobs re-runs only the failed jobs of the workflow run runID, and the jobs depending on them
+func (c *Client) RerunFailedJobs(repoName string, runID int64) error {
+
+	u := fmt.Sprintf("repos/%s/%s/actions/runs/%d/rerun-failed-jobs", c.Organization, repoName, runID)
+	if _, err := c.do("POST", u, nil, nil); err != nil {
+		if statusCode(err) == http.StatusForbidden {
+			return fmt.Errorf("workflow run %d of %s cannot be re-run, it is too old or still running: %w", runID, repoName, err)
+		}
+		return notFound(err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBilledMinutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		runnerOS string
+		bill     string
+		minutes  int64
+	}{
+		{"under a minute", "UBUNTU", `{"total_ms":1500,"job_runs":[{"job_id":1,"duration_ms":1500}]}`, 1},
+		{"on a minute boundary", "UBUNTU", `{"total_ms":120000,"job_runs":[{"job_id":1,"duration_ms":120000}]}`, 2},
+		{"just past a minute", "UBUNTU", `{"total_ms":60001,"job_runs":[{"job_id":1,"duration_ms":60001}]}`, 2},
+		{"every job rounded", "UBUNTU", `{"total_ms":60000,"job_runs":[{"job_id":1,"duration_ms":30000},{"job_id":2,"duration_ms":30000}]}`, 2},
+		{"without jobs detail", "UBUNTU", `{"total_ms":61000}`, 2},
+		{"nothing run", "UBUNTU", `{"total_ms":0}`, 0},
+		{"macOS runner", "MACOS", `{"total_ms":30000,"job_runs":[{"job_id":1,"duration_ms":30000}]}`, 10},
+		{"Windows runner", "WINDOWS", `{"total_ms":90000,"job_runs":[{"job_id":1,"duration_ms":90000}]}`, 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var bill runBill
+			assert.NoError(t, json.Unmarshal([]byte(test.bill), &bill))
+			assert.Equal(t, test.minutes, billedMinutes(test.runnerOS, bill))
+		})
+	}
+}
+
+func TestWorkflowCost(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/repos/org/repo/actions/workflows/9/runs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":3,"workflow_runs":[
+			{"id":1,"created_at":"2024-05-03T00:00:00Z"},
+			{"id":2,"created_at":"2024-05-02T00:00:00Z"},
+			{"id":3,"created_at":"2024-04-30T00:00:00Z"}]}`)
+	})
+	mux.HandleFunc("/repos/org/repo/actions/runs/1/timing", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"billable":{"UBUNTU":{"total_ms":61000,"jobs":1,"job_runs":[{"job_id":1,"duration_ms":61000}]},
+			"MACOS":{"total_ms":1000,"jobs":1,"job_runs":[{"job_id":2,"duration_ms":1000}]}}}`)
+	})
+	mux.HandleFunc("/repos/org/repo/actions/runs/2/timing", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"billable":{"WINDOWS":{"total_ms":60000,"jobs":1,"job_runs":[{"job_id":3,"duration_ms":60000}]}}}`)
+	})
+
+	cost, err := client.WorkflowCost("repo", 9, since)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"UBUNTU": 2, "MACOS": 10, "WINDOWS": 2}, cost)
+}
@@ -0,0 +1,53 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+)
+
+// SuspendUser suspends username on a GitHub Enterprise install, recording reason in its audit log.
+// It needs a client built WithBaseURL and a site administrator token
+func (c *Client) SuspendUser(username, reason string) error {
+
+	if err := c.siteAdmin(); err != nil {
+		return err
+	}
+
+	var opts *github.UserSuspendOptions
+	if len(reason) > 0 {
+		opts = &github.UserSuspendOptions{Reason: github.String(reason)}
+	}
+	_, err := c.github.Users.Suspend(c.ctx, username, opts)
+	return siteAdminError(err, username)
+}
+
+// UnsuspendUser lifts the suspension of username on a GitHub Enterprise install.
+// It needs a client built WithBaseURL and a site administrator token
+func (c *Client) UnsuspendUser(username string) error {
+
+	if err := c.siteAdmin(); err != nil {
+		return err
+	}
+
+	_, err := c.github.Users.Unsuspend(c.ctx, username)
+	return siteAdminError(err, username)
+}
+
+// siteAdmin fails when the client does not talk to a GitHub Enterprise install, the only one with user suspension
+func (c *Client) siteAdmin() error {
+
+	if !c.enterprise() {
+		return fmt.Errorf("user suspension is only available on GitHub Enterprise, build the client WithBaseURL")
+	}
+	return nil
+}
+
+// siteAdminError explains a 403 answer to a site administrator call on username
+func siteAdminError(err error, username string) error {
+
+	if statusCode(err) == http.StatusForbidden {
+		return fmt.Errorf("cannot change the suspension of %s, the token is not a site administrator: %w", username, err)
+	}
+	return notFound(err)
+}
@@ -0,0 +1,33 @@
+package git
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSuspendUser(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+	assert.Error(t, client.SuspendUser("octocat", "left"))
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/api/v3/users/octocat/suspended", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v3/users/admin/suspended", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"Must be a site admin"}`))
+	})
+
+	enterprise := New("", WithBaseURL(server.URL))
+	assert.NoError(t, enterprise.SuspendUser("octocat", "left"))
+
+	err := enterprise.UnsuspendUser("admin")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not a site administrator")
+}
@@ -0,0 +1,142 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"golang.org/x/oauth2"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// appJWTLifetime is how long the JWT authenticating a GitHub App is valid, GitHub accepts at most 10 minutes
+const appJWTLifetime = 9 * time.Minute
+
+// tokenMintTimeout bounds the minting of an installation token, which no call context governs
+const tokenMintTimeout = 30 * time.Second
+
+// NewFromAppInstallation creates a github Client authenticated as the installation installationID of the GitHub
+// App appID, privateKey is the PEM private key of the App. Installation tokens are minted on first use and
+// minted again when they expire, independently of the client context so a cancelled one never stops refreshes
+func NewFromAppInstallation(appID, installationID int64, privateKey []byte, opts ...Option) (*Client, error) {
+
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &installationTokenSource{appID: appID, installationID: installationID, key: key, now: time.Now}
+	client := New("", append(opts, WithTokenSource(source))...)
+
+	// the tokens are minted against the same API with a client that does not carry them
+	source.baseURL = client.github.BaseURL.String()
+	source.httpClient = http.DefaultClient
+	if client.httpClient != nil {
+		source.httpClient = client.httpClient
+	}
+	return client, nil
+}
+
+// parsePrivateKey decodes a PEM RSA private key in PKCS#1 or PKCS#8 form
+func parsePrivateKey(privateKey []byte) (*rsa.PrivateKey, error) {
+
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, fmt.Errorf("private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// installationTokenSource is an oauth2.TokenSource minting GitHub App installation tokens
+type installationTokenSource struct {
+	appID          int64
+	installationID int64
+	key            *rsa.PrivateKey
+	baseURL        string
+	httpClient     *http.Client
+	now            func() time.Time
+}
+
+// Token implements oauth2.TokenSource
+func (s *installationTokenSource) Token() (*oauth2.Token, error) {
+
+	jwt, err := s.jwt()
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%sapp/installations/%d/access_tokens", s.baseURL, s.installationID)
+	ctx, cancel := context.WithTimeout(context.Background(), tokenMintTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Bearer "+jwt)
+	request.Header.Set("Accept", "application/vnd.github.v3+json")
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("installation token of %d not minted: %s", s.installationID, response.Status)
+	}
+
+	var token struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{AccessToken: token.Token, TokenType: "token", Expiry: token.ExpiresAt}, nil
+}
+
+// jwt returns the RS256 JSON Web Token authenticating the App, backdated a minute against clock drift
+func (s *installationTokenSource) jwt() (string, error) {
+
+	now := s.now()
+	claims, err := json.Marshal(map[string]int64{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": s.appID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	var unsigned bytes.Buffer
+	unsigned.WriteString(encoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`)))
+	unsigned.WriteString(".")
+	unsigned.WriteString(encoding.EncodeToString(claims))
+
+	digest := sha256.Sum256(unsigned.Bytes())
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return strings.Join([]string{unsigned.String(), encoding.EncodeToString(signature)}, "."), nil
+}
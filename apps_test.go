@@ -0,0 +1,86 @@
+package git
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewFromAppInstallation(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	mints := 0
+	lifetime := time.Hour
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/app/installations/7/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), ".")
+		assert.Len(t, parts, 3)
+		signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+
+		var claims map[string]int64
+		payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		assert.NoError(t, json.Unmarshal(payload, &claims))
+		assert.Equal(t, int64(42), claims["iss"])
+
+		mints++
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"token":"tk-%d","expires_at":%q}`, mints, time.Now().Add(lifetime).Format(time.RFC3339))
+	})
+	var tokens []string
+	mux.HandleFunc("/api/v3/repos/org/repo", func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"name":"repo"}`))
+	})
+
+	client, err := NewFromAppInstallation(42, 7, privateKey, WithBaseURL(server.URL), WithOrganization("org"))
+	assert.NoError(t, err)
+	assert.NotNil(t, client.Repository("repo"))
+	assert.NotNil(t, client.Repository("repo"))
+	assert.Equal(t, []string{"token tk-1", "token tk-1"}, tokens)
+
+	// a token about to expire is minted again
+	lifetime = time.Second
+	tokens = nil
+	client, err = NewFromAppInstallation(42, 7, privateKey, WithBaseURL(server.URL), WithOrganization("org"))
+	assert.NoError(t, err)
+	assert.NotNil(t, client.Repository("repo"))
+	assert.NotNil(t, client.Repository("repo"))
+	assert.Equal(t, []string{"token tk-2", "token tk-3"}, tokens)
+
+	// a cancelled construction context does not stop the refreshes of the calls made with a fresh one
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	lifetime = time.Hour
+	tokens = nil
+	client, err = NewFromAppInstallation(42, 7, privateKey, WithBaseURL(server.URL), WithOrganization("org"), WithContext(cancelled))
+	assert.NoError(t, err)
+	assert.Nil(t, client.Repository("repo"))
+	assert.NotNil(t, client.WithContext(context.Background()).Repository("repo"))
+	assert.Equal(t, []string{"token tk-4"}, tokens)
+}
+
+func TestNewFromAppInstallationInvalidKey(t *testing.T) {
+	client, err := NewFromAppInstallation(42, 7, []byte("not a key"))
+	assert.Nil(t, client)
+	assert.Error(t, err)
+}
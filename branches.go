@@ -0,0 +1,272 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// activityConcurrency bounds how many head commits BranchesWithActivity reads at a time
+const activityConcurrency = 8
+
+// refPollInterval and refPollMaxInterval bound the backoff of WaitForRef between two lookups
+const (
+	refPollInterval    = 100 * time.Millisecond
+	refPollMaxInterval = 2 * time.Second
+)
+
+// BranchActivity is a branch and the committer date of its head commit
+type BranchActivity struct {
+	Name string
+	SHA  string
+	Date time.Time
+}
+
+// BranchSHA returns the SHA of the head commit of branch, the lightest way to get a branch tip
+func (c *Client) BranchSHA(repoName, branch string) (string, error) {
+
+	ref, _, err := c.github.Git.GetRef(c.ctx, c.Organization, repoName, "refs/heads/"+branch)
+	if err != nil {
+		return "", notFound(err)
+	}
+	return ref.GetObject().GetSHA(), nil
+}
+
+// CreateBranch creates newBranch in repoName pointing to fromSHA, newBranch is a plain name like "feature/x"
+func (c *Client) CreateBranch(repoName, newBranch, fromSHA string) (*github.Reference, error) {
+
+	if newBranch == "" || strings.HasPrefix(newBranch, "refs/") {
+		return nil, fmt.Errorf("invalid branch name %q, the refs/heads/ prefix is added on creation", newBranch)
+	}
+
+	newRef := &github.Reference{Ref: github.String("refs/heads/" + newBranch), Object: &github.GitObject{SHA: github.String(fromSHA)}}
+	ref, _, err := c.github.Git.CreateRef(c.ctx, c.Organization, repoName, newRef)
+	if err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
+
+// DeleteBranch removes branch from repoName
+func (c *Client) DeleteBranch(repoName, branch string) error {
+
+	_, err := c.github.Git.DeleteRef(c.ctx, c.Organization, repoName, "refs/heads/"+branch)
+	return err
+}
+
+// WaitForRef polls ref of repoName, like "heads/feature" or "refs/tags/v1.0.0", until GitHub resolves it or timeout
+// elapses, covering the delay before a freshly created ref is visible. A ref still missing returns ErrNotFound
+func (c *Client) WaitForRef(repoName, ref string, timeout time.Duration) (*github.Reference, error) {
+
+	deadline := time.Now().Add(timeout)
+	interval := refPollInterval
+	for {
+		reference, _, err := c.github.Git.GetRef(c.ctx, c.Organization, repoName, ref)
+		if err == nil {
+			return reference, nil
+		}
+		if err = notFound(err); err != ErrNotFound {
+			return nil, err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, fmt.Errorf("ref %s not visible after %s: %w", ref, timeout, ErrNotFound)
+		}
+		if interval > remaining {
+			interval = remaining
+		}
+		if err = c.sleep(interval); err != nil {
+			return nil, err
+		}
+		if interval *= 2; interval > refPollMaxInterval {
+			interval = refPollMaxInterval
+		}
+	}
+}
+
+// DeleteBranchesMatching deletes, at most concurrency at a time, the branches of repoName whose name matches
+// pattern, a glob like "release/*" or a regular expression enclosed in slashes like "/^release-[0-9]+$/".
+// The default branch is never deleted. With dryRun nothing is deleted and the matching names are returned.
+// Cancelling the client context stops further deletions, the branches already deleted are returned with its error
+func (c *Client) DeleteBranchesMatching(repoName, pattern string, concurrency int, dryRun bool) ([]string, error) {
+
+	match, err := branchMatcher(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	repo, _, err := c.github.Repositories.Get(c.ctx, c.Organization, repoName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	branches, err := c.allBranches(repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []string
+	for _, branch := range branches {
+		if name := branch.GetName(); name != repo.GetDefaultBranch() && match(name) {
+			matching = append(matching, name)
+		}
+	}
+	if dryRun {
+		sort.Strings(matching)
+		return matching, nil
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	deleted := make([]string, 0, len(matching))
+	semaphore := make(chan struct{}, concurrency)
+
+	for _, name := range matching {
+		select {
+		case <-c.ctx.Done():
+		case semaphore <- struct{}{}:
+		}
+		// a slot freed along with the cancellation must not start another deletion
+		if err = c.ctx.Err(); err != nil {
+			wg.Wait()
+			sort.Strings(deleted)
+			return deleted, err
+		}
+
+		wg.Add(1)
+		go func(name string) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			err := c.DeleteBranch(repoName, name)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("deleting branch %s: %w", name, err)
+				}
+				return
+			}
+			deleted = append(deleted, name)
+		}(name)
+	}
+	wg.Wait()
+
+	sort.Strings(deleted)
+	return deleted, firstErr
+}
+
+// branchMatcher compiles pattern, a glob or a /regular expression/, into a branch name matcher
+func branchMatcher(pattern string) (func(name string) bool, error) {
+
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		expression, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid branch pattern %q: %w", pattern, err)
+		}
+		return expression.MatchString, nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid branch pattern %q: %w", pattern, err)
+	}
+	return func(name string) bool {
+		matched, _ := path.Match(pattern, name)
+		return matched
+	}, nil
+}
+
+// allBranches returns every branch of repoName, walking all pages
+func (c *Client) allBranches(repoName string) ([]*github.Branch, error) {
+	//
+	opts := &github.BranchListOptions{ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	var branches []*github.Branch
+	for {
+		branch, response, err := c.github.Repositories.ListBranches(c.ctx, c.Organization, repoName, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		branches = append(branches, branch...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return branches, nil
+}
+
+// BranchesWithActivity returns the branches of repoName with the date of their last commit, oldest first so the
+// stale ones come at the top. The branch listing carries the head commit SHA only, the dates are read from the
+// head commits, activityConcurrency at a time
+func (c *Client) BranchesWithActivity(repoName string) ([]BranchActivity, error) {
+
+	branches, err := c.allBranches(repoName)
+	if err != nil {
+		if emptyRepository(err) == ErrEmptyRepository {
+			return []BranchActivity{}, nil
+		}
+		return nil, err
+	}
+
+	activities := make([]BranchActivity, len(branches))
+	var firstErr error
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, activityConcurrency)
+
+	for i, branch := range branches {
+		activities[i] = BranchActivity{Name: branch.GetName(), SHA: branch.GetCommit().GetSHA()}
+		if date := branch.GetCommit().GetCommit().GetCommitter().GetDate(); !date.IsZero() {
+			activities[i].Date = date
+			continue
+		}
+
+		select {
+		case <-c.ctx.Done():
+			wg.Wait()
+			return nil, c.ctx.Err()
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(activity *BranchActivity) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			commit, _, err := c.github.Git.GetCommit(c.ctx, c.Organization, repoName, activity.SHA)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("head commit of %s: %w", activity.Name, notFound(err))
+				}
+				return
+			}
+			activity.Date = commit.GetCommitter().GetDate()
+		}(&activities[i])
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].Date.Before(activities[j].Date)
+	})
+	return activities, nil
+}
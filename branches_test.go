@@ -0,0 +1,144 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBranchMatcher(t *testing.T) {
+	glob, err := branchMatcher("release/*")
+	assert.NoError(t, err)
+	assert.True(t, glob("release/1.0"))
+	assert.False(t, glob("release/1.0/hotfix"))
+	assert.False(t, glob("feature/release"))
+
+	expression, err := branchMatcher("/^release-[0-9]+$/")
+	assert.NoError(t, err)
+	assert.True(t, expression("release-12"))
+	assert.False(t, expression("release-x"))
+
+	_, err = branchMatcher("/[/")
+	assert.Error(t, err)
+	_, err = branchMatcher("[")
+	assert.Error(t, err)
+}
+
+func TestWaitForRef(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	lookups := 0
+	mux.HandleFunc("/repos/org/repo/git/ref/heads/feature", func(w http.ResponseWriter, r *http.Request) {
+		if lookups++; lookups < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ref":"refs/heads/feature","object":{"sha":"abc"}}`))
+	})
+
+	ref, err := client.WaitForRef("repo", "heads/feature", 5*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", ref.GetObject().GetSHA())
+	assert.Equal(t, 3, lookups)
+
+	_, err = client.WaitForRef("repo", "heads/missing", 50*time.Millisecond)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestBranchesWithActivity(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/branches", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"main","commit":{"sha":"a"}},{"name":"stale","commit":{"sha":"b"}},
+			{"name":"listed","commit":{"sha":"c","commit":{"committer":{"date":"2020-03-01T00:00:00Z"}}}}]`))
+	})
+	mux.HandleFunc("/repos/org/repo/git/commits/a", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"sha":"a","committer":{"date":"2020-06-01T00:00:00Z"}}`))
+	})
+	mux.HandleFunc("/repos/org/repo/git/commits/b", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"sha":"b","committer":{"date":"2019-01-01T00:00:00Z"}}`))
+	})
+
+	activities, err := client.BranchesWithActivity("repo")
+	assert.NoError(t, err)
+	var names []string
+	for _, activity := range activities {
+		names = append(names, activity.Name)
+	}
+	assert.Equal(t, []string{"stale", "listed", "main"}, names)
+	assert.Equal(t, 2019, activities[0].Date.Year())
+}
+
+func TestCreateBranchFromTag(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var request struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	}
+	mux.HandleFunc("/repos/org/repo/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		fmt.Fprint(w, `{"ref":"refs/heads/hotfix/1.0.1","object":{"sha":"tag1"}}`)
+	})
+
+	ref, err := client.CreateBranch("repo", "hotfix/1.0.1", "tag1")
+	assert.NoError(t, err)
+	assert.Equal(t, "refs/heads/hotfix/1.0.1", ref.GetRef())
+	assert.Equal(t, "refs/heads/hotfix/1.0.1", request.Ref)
+	assert.Equal(t, "tag1", request.SHA)
+
+	_, err = client.CreateBranch("repo", "refs/heads/hotfix", "tag1")
+	assert.Error(t, err)
+}
+
+func TestDeleteMissingBranch(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/git/refs/heads/gone", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Reference does not exist"}`)
+	})
+
+	err := client.DeleteBranch("repo", "gone")
+	var response *github.ErrorResponse
+	if assert.True(t, errors.As(err, &response)) {
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Response.StatusCode)
+	}
+}
+
+func TestDeleteBranchesMatchingStopsWhenCancelled(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"repo","default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/org/repo/branches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"main"},{"name":"tmp/a"},{"name":"tmp/b"},{"name":"tmp/c"},{"name":"tmp/d"}]`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deletes := 0
+	mux.HandleFunc("/repos/org/repo/git/refs/heads/tmp/", func(w http.ResponseWriter, r *http.Request) {
+		deletes++
+		cancel()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.WithContext(ctx).DeleteBranchesMatching("repo", "tmp/*", 1, false)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, deletes)
+}
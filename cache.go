@@ -0,0 +1,162 @@
+package git
+
+import (
+	"bytes"
+	"github.com/google/go-github/v32/github"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// cacheMaxEntries bounds the responses kept by the ETag cache, an entry is dropped to make room for a new one
+const cacheMaxEntries = 1000
+
+// cacheMaxEntrySize is the largest body the ETag cache keeps, larger responses are streamed untouched
+const cacheMaxEntrySize = 1 << 20
+
+// CacheStats counts the GET requests seen by the ETag cache enabled with WithCache. Hits were answered by
+// GitHub with a 304, served from the cache without consuming rate limit, misses got a full response
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// CacheStats returns the counters of the ETag cache of the client and its copies, zero when WithCache is not set
+func (c *Client) CacheStats() CacheStats {
+
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return c.cache.stats()
+}
+
+// cacheEntry is a successful response kept by cacheTransport along with its ETag
+type cacheEntry struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// cacheTransport makes the GET requests to the API going through base conditional on the ETag of their previous
+// response, keyed by URL and Accept header, and answers a 304 with the cached response. Downloads from other
+// hosts, range requests and bodies over cacheMaxEntrySize are never buffered
+type cacheTransport struct {
+	base    http.RoundTripper
+	api     *github.Client
+	mutex   sync.Mutex
+	entries map[string]*cacheEntry
+	hits    int64
+	misses  int64
+}
+
+// newCacheTransport returns an empty cacheTransport whose base is set once the client transports are built
+func newCacheTransport() *cacheTransport {
+
+	return &cacheTransport{entries: make(map[string]*cacheEntry)}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	// conditional requests set by the caller are its own business
+	if !t.cacheable(req) || req.Header.Get("If-None-Match") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	key := req.Header.Get("Accept") + " " + req.URL.String()
+	t.mutex.Lock()
+	entry := t.entries[key]
+	t.mutex.Unlock()
+
+	if entry != nil {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+
+	response, err := t.base.RoundTrip(req)
+	if err != nil {
+		return response, err
+	}
+
+	if entry != nil && response.StatusCode == http.StatusNotModified {
+		_ = response.Body.Close()
+
+		// the fresh headers, rate limits among them, win over the cached ones
+		header := entry.header.Clone()
+		for name, values := range response.Header {
+			header[name] = values
+		}
+		response.StatusCode = http.StatusOK
+		response.Status = "200 OK"
+		response.Header = header
+		response.Body = ioutil.NopCloser(bytes.NewReader(entry.body))
+		response.ContentLength = int64(len(entry.body))
+
+		t.mutex.Lock()
+		t.hits++
+		t.mutex.Unlock()
+		return response, nil
+	}
+
+	t.mutex.Lock()
+	t.misses++
+	t.mutex.Unlock()
+
+	etag := response.Header.Get("ETag")
+	if response.StatusCode != http.StatusOK || etag == "" || response.ContentLength > cacheMaxEntrySize {
+		return response, nil
+	}
+	// a length is missing only when the transport decompressed the body, any other unknown length is streamed
+	if response.ContentLength < 0 && !response.Uncompressed {
+		return response, nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, cacheMaxEntrySize+1))
+	if err != nil {
+		_ = response.Body.Close()
+		return nil, err
+	}
+	if len(body) > cacheMaxEntrySize {
+		response.Body = &prefixedBody{Reader: io.MultiReader(bytes.NewReader(body), response.Body), Closer: response.Body}
+		return response, nil
+	}
+	_ = response.Body.Close()
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.mutex.Lock()
+	if _, ok := t.entries[key]; !ok && len(t.entries) >= cacheMaxEntries {
+		for evicted := range t.entries {
+			delete(t.entries, evicted)
+			break
+		}
+	}
+	t.entries[key] = &cacheEntry{etag: etag, header: response.Header.Clone(), body: body}
+	t.mutex.Unlock()
+	return response, nil
+}
+
+// cacheable reports whether req is a GET of the whole of an API resource
+func (t *cacheTransport) cacheable(req *http.Request) bool {
+
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" || t.api == nil {
+		return false
+	}
+	return req.URL.Host == t.api.BaseURL.Host && strings.HasPrefix(req.URL.Path, t.api.BaseURL.Path)
+}
+
+// prefixedBody is a response body whose first bytes were already read, closing it closes the original body
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// stats returns a snapshot of the counters
+func (t *cacheTransport) stats() CacheStats {
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return CacheStats{Hits: t.hits, Misses: t.misses}
+}
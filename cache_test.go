@@ -0,0 +1,85 @@
+package git
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestWithCacheServesNotModified(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var calls int
+	mux.HandleFunc("/repos/org/repo/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("ETag", `"b1"`)
+		if r.Header.Get("If-None-Match") == `"b1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `{"name":"main","commit":{"sha":"c0ffee"}}`)
+	})
+
+	client := New("", WithCache(), WithOrganization("org"))
+	client.github.BaseURL, _ = url.Parse(server.URL + "/")
+
+	first := client.Branch("repo", "main")
+	second := client.Branch("repo", "main")
+	assert.Equal(t, 2, calls)
+	if assert.NotNil(t, second) {
+		assert.Equal(t, first, second)
+		assert.Equal(t, "c0ffee", second.GetCommit().GetSHA())
+	}
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, client.CacheStats())
+	assert.Equal(t, CacheStats{}, New("").CacheStats())
+}
+
+func TestWithCacheLeavesDownloadsAndLargeBodiesAlone(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var conditional []string
+	raw := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conditional = append(conditional, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"raw"`)
+		fmt.Fprint(w, "raw content")
+	}))
+	defer raw.Close()
+
+	mux.HandleFunc("/repos/org/repo/contents/dist", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"type":"file","name":"app.txt","path":"dist/app.txt","download_url":"%s/app.txt"}]`, raw.URL)
+	})
+	large := strings.Repeat("x", cacheMaxEntrySize+1)
+	mux.HandleFunc("/repos/org/repo/readme", func(w http.ResponseWriter, r *http.Request) {
+		conditional = append(conditional, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"large"`)
+		fmt.Fprintf(w, `{"name":"README.md","content":"%s"}`, large)
+	})
+
+	client := New("", WithCache(), WithOrganization("org"))
+	client.github.BaseURL, _ = url.Parse(server.URL + "/")
+
+	for i := 0; i < 2; i++ {
+		body, err := client.Download("repo", "main", "dist/app.txt")
+		if assert.NoError(t, err) {
+			content, _ := ioutil.ReadAll(body)
+			body.Close()
+			assert.Equal(t, "raw content", string(content))
+		}
+
+		readme, _, err := client.github.Repositories.GetReadme(client.ctx, "org", "repo", nil)
+		if assert.NoError(t, err) {
+			assert.Len(t, *readme.Content, len(large))
+		}
+	}
+	assert.Equal(t, []string{"", "", "", ""}, conditional)
+	assert.Equal(t, int64(0), client.CacheStats().Hits)
+}
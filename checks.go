@@ -0,0 +1,63 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+)
+
+// CheckSuites returns the check suites reported for ref, each with its Status, Conclusion and App
+func (c *Client) CheckSuites(repoName, ref string) ([]*github.CheckSuite, error) {
+	//
+	opts := &github.ListCheckSuiteOptions{ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	var suites []*github.CheckSuite
+	for {
+		result, response, err := c.github.Checks.ListCheckSuitesForRef(c.ctx, c.Organization, repoName, ref, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		suites = append(suites, result.CheckSuites...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return suites, nil
+}
+
+// RerequestCheckSuite asks GitHub to run again the check suite identified by suiteID
+func (c *Client) RerequestCheckSuite(repoName string, suiteID int64) error {
+
+	_, err := c.github.Checks.ReRequestCheckSuite(c.ctx, c.Organization, repoName, suiteID)
+	return notFound(err)
+}
+
+// CheckRunAnnotations returns the annotations of the check run checkRunID, each with its Path,
+// StartLine and EndLine, AnnotationLevel and Message
+func (c *Client) CheckRunAnnotations(repoName string, checkRunID int64) ([]*github.CheckRunAnnotation, error) {
+	//
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	var annotations []*github.CheckRunAnnotation
+	for {
+		annotation, response, err := c.github.Checks.ListCheckRunAnnotations(c.ctx, c.Organization, repoName, checkRunID, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		annotations = append(annotations, annotation...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return annotations, nil
+}
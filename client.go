@@ -3,69 +3,272 @@ package git
 import (
 	"context"
 	"fmt"
-	"github.com/dotWicho/utilities"
 	"github.com/google/go-github/v32/github"
 	"golang.org/x/oauth2"
 	"io"
+	"math/rand"
 	"net/http"
 	"path"
 	"strings"
+	"time"
 )
 
+// writeConcurrency is how many writes the write semaphore of a client lets run at the same time
+const writeConcurrency = 4
+
 // Operations interface
 type Operations interface {
 	Commit(repoName, commitSHA string) *github.Commit
+	CommitE(repoName, commitSHA string) (*github.Commit, error)
 	Compare(repoName, base, head string) *github.CommitsComparison
 	Merge(repoName, base, head, message string) *github.RepositoryCommit
 	Repositories(repoType, repoSort string) []*github.Repository
 	Repository(repoName string) *github.Repository
 	Branches(repoName string) []*github.Branch
+	BranchesE(repoName string) ([]*github.Branch, error)
 	Branch(repoName, branchName string) *github.Branch
 	Tags(repoName string) []*github.RepositoryTag
+	TagsE(repoName string) ([]*github.RepositoryTag, error)
 	TagByName(repoName, tagName string) *github.RepositoryTag
 	ReferenceByBranch(repoName, branchName string) *github.Reference
 	ReferenceByHeads(repoName, branchName string) *github.Reference
 	ReferenceByTag(repoName, tagName string) *github.Reference
 	CreateRefs(repoName, branchName, SHARef string) *github.Reference
 	Tree(repoName, sourceFiles string, reference *github.Reference) *github.Tree
+	TreeFromContent(repoName string, files map[string][]byte, reference *github.Reference) (*github.Tree, error)
 	Users() []*github.User
 	User(userName string) *github.User
 	CreatePullRequest(repoName, srcBranch, dstBranch, subject, description string) *github.PullRequest
+	CreatePullRequestE(repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, error)
 	AssignReviewers(id int, repoName string, reviewers []string) *github.PullRequest
+	AssignReviewersE(id int, repoName string, reviewers []string) (*github.PullRequest, error)
+	RerequestReviewers(id int, repoName string, reviewers []string) *github.PullRequest
 	Download(repoName, refName, filePath string) (body io.ReadCloser, err error)
+	ImportProgress(repoName string) (*github.Import, error)
+	StartImport(repoName string, req *github.Import) (*github.Import, error)
+	GPGKeys() ([]*github.GPGKey, error)
+	CreateGPGKey(armoredKey string) (*github.GPGKey, error)
+	DeleteGPGKey(id int64) error
+	MergePullRequest(repoName string, number int, commitMessage string, method string) (*github.PullRequestMergeResult, error)
+	CheckSuites(repoName, ref string) ([]*github.CheckSuite, error)
+	RerequestCheckSuite(repoName string, suiteID int64) error
+	Subscribe(repoName string, subscribed, ignored bool) (*github.Subscription, error)
+	Unsubscribe(repoName string) error
+	PullRequestForBranch(repoName, branch string) (*github.PullRequest, error)
+	ReleaseAssets(repoName string, releaseID int64) ([]*github.ReleaseAsset, error)
+	DeleteReleaseAsset(repoName string, assetID int64) error
+	ProtectionDrift(repoName, branch string, desired *github.ProtectionRequest) ([]string, error)
+	CreateRepositoryInitialized(repoName string, private bool, gitignoreTemplate, licenseTemplate string) (*github.Repository, error)
+	LatestRelease(repoName string) (*github.RepositoryRelease, error)
+	LatestSemverTag(repoName string) (string, error)
+	RepositorySummaries(repoNames []string, concurrency int) (map[string]*RepoSummary, error)
+	WorkflowRunUsage(repoName string, runID int64) (*github.WorkflowRunUsage, error)
+	WorkflowCost(repoName string, workflowID int64, since time.Time) (map[string]int64, error)
+	PullRequestsAwaitingReview() ([]*github.PullRequest, error)
+	ClearDefaultLabels(repoName string) error
+	DownloadArchiveTo(repoName, ref string, format github.ArchiveFormat, w io.Writer, progress func(bytesWritten int64)) error
+	SetRetryPolicy(policy RetryPolicy)
+	WithRetryPolicy(policy RetryPolicy) *Client
+	ResolveRef(repoName, ref string) (string, error)
+	CommitByRef(repoName, ref string) (*github.RepositoryCommit, error)
+	GenerateReleaseNotes(repoName, tagName, previousTag string) (*ReleaseNotes, error)
+	Milestones(repoName, state string) []*github.Milestone
+	MilestoneProgress(repoName string, number int) (percent float64, err error)
+	DeleteBranch(repoName, branch string) error
+	DeleteBranchesMatching(repoName, pattern string, concurrency int, dryRun bool) ([]string, error)
+	SecretScanningAlerts(repoName string, state string) ([]*SecretScanningAlert, error)
+	CodeScanningAlerts(repoName, state string) ([]*github.Alert, error)
+	SBOM(repoName string) (*SBOM, error)
+	CheckRunAnnotations(repoName string, checkRunID int64) ([]*github.CheckRunAnnotation, error)
+	EnsureLabels(repoName string, labels []*github.Label) error
+	FilesFromTree(repoName, ref string, paths []string) (map[string][]byte, error)
+	CreateIssueFromRequest(repoName string, req *github.IssueRequest) (*github.Issue, error)
+	MergeQueueEntries(repoName, branch string) ([]MergeQueueEntry, error)
+	ClosePullRequest(repoName string, number int) (*github.PullRequest, error)
+	ClosePullRequestWithReason(repoName string, number int, reason string) (*github.PullRequest, error)
+	ReopenPullRequest(repoName string, number int) (*github.PullRequest, error)
+	ReopenIssue(repoName string, number int) (*github.Issue, error)
+	AssignToLeastLoaded(repoName string, number int, candidates []string) (*github.Issue, error)
+	ForkParent(repoName string) (*github.Repository, error)
+	ForkSource(repoName string) (*github.Repository, error)
+	PendingDeployments(repoName string, runID int64) ([]*PendingDeployment, error)
+	ApproveDeployment(repoName string, runID int64, envIDs []int64, comment string) error
+	BranchSHA(repoName, branch string) (string, error)
+	RepositoriesPushedSince(since time.Time) ([]*github.Repository, error)
+	DependabotSecrets(repoName string) ([]*github.Secret, error)
+	CreateOrUpdateDependabotSecret(repoName, name string, plaintext []byte) error
+	DeleteDependabotSecret(repoName, name string) error
+	DownloadResumable(repoName, ref, filePath string, w io.WriteSeeker) error
+	StatusesByCreator(repoName, ref string) (map[string][]*github.RepoStatus, error)
+	Revert(repoName, commitSHA, branch string) (*github.RepositoryCommit, error)
+	FileMeta(repoName, filePath, ref string) (*FileInfo, error)
+	FileIsBinary(repoName, filePath, ref string) (bool, error)
+	StartMigration(repoNames []string, lockRepositories bool) (*github.Migration, error)
+	MigrationStatus(id int64) (*github.Migration, error)
+	DownloadMigrationArchive(id int64) (io.ReadCloser, error)
+	SetFeatures(repoName string, hasIssues, hasWiki, hasProjects, hasDiscussions *bool) (*github.Repository, error)
+	WaitForRef(repoName, ref string, timeout time.Duration) (*github.Reference, error)
+	ReviewRequestAges(repoName string, number int) (map[string]time.Duration, error)
+	LabelInventory() (map[string]int, error)
+	GitignoreTemplates() ([]string, error)
+	GitignoreTemplate(name string) (*github.Gitignore, error)
+	LicenseTemplates() ([]*github.License, error)
+	LicenseTemplate(key string) (*github.License, error)
+	RerunFailedJobs(repoName string, runID int64) error
+	EstimateCount(endpoint string) (int, error)
+	BranchCount(repoName string) (int, error)
+	TagCount(repoName string) (int, error)
+	ApplySuggestion(repoName string, commentID int64) (*github.Commit, error)
+	RateLimits() (*github.RateLimits, error)
+	MergeablePullRequests(repoName string) ([]*github.PullRequest, error)
+	SuspendUser(username, reason string) error
+	UnsuspendUser(username string) error
+	RepositoriesIter(repoType, repoSort string) (func(yield func(*github.Repository) bool), func() error)
+	Rulesets(repoName string) ([]*Ruleset, error)
+	Ruleset(repoName string, id int64) (*Ruleset, error)
+	CreateFile(repoName, branch, filePath, message string, content []byte) (*github.RepositoryContentResponse, error)
+	UpdateFile(repoName, branch, filePath, message string, content []byte, sha string) (*github.RepositoryContentResponse, error)
+	DeleteFile(repoName, branch, filePath, message, sha string) (*github.RepositoryContentResponse, error)
+	SearchInRepo(repoName, query string) (*github.CodeSearchResult, error)
+	Releases(repoName string) []*github.RepositoryRelease
+	ReleaseByTag(repoName, tag string) *github.RepositoryRelease
+	CreateRelease(repoName string, r *github.RepositoryRelease) (*github.RepositoryRelease, error)
+	CompareAll(repoName, base, head string) (*github.CommitsComparison, error)
+	DiffStats(repoName, base, head string) (files, additions, deletions int, err error)
+	Issues(repoName string, state string) []*github.Issue
+	Issue(repoName string, number int) *github.Issue
+	CreateIssue(repoName, title, body string, labels []string) (*github.Issue, error)
+	CommitsByAuthor(repoName, authorEmail string, since time.Time) ([]*github.RepositoryCommit, error)
+	Labels(repoName string) []*github.Label
+	AddLabelsToIssue(repoName string, number int, labels []string) ([]*github.Label, error)
+	RemoveLabelForIssue(repoName string, number int, label string) error
+	AuthenticatedCloneURL(repoName string) (string, error)
+	CommentOnIssue(repoName string, number int, body string) (*github.IssueComment, error)
+	Comments(repoName string, number int) []*github.IssueComment
+	BranchesWithActivity(repoName string) ([]BranchActivity, error)
+	EnsurePullRequest(repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, bool, error)
+	UpdatePullRequest(repoName string, number int, updates *github.PullRequest) (*github.PullRequest, error)
+	ArchiveAndSnapshot(repoName string) (archiveURL string, err error)
+	PullRequests(repoName, state string) []*github.PullRequest
+	PullRequest(repoName string, number int) *github.PullRequest
+	TeamRepositories(teamSlug string) ([]*github.Repository, error)
+	AllTeamRepositories() (map[string][]*github.Repository, error)
+	AssignTeamReviewers(id int, repoName string, teams []string) (*github.PullRequest, error)
+	RequestReviews(id int, repoName string, users, teams []string) (*github.PullRequest, error)
+	HookDeliveries(repoName string, hookID int64) ([]*HookDelivery, error)
+	RedeliverHook(repoName string, hookID, deliveryID int64) error
+	CreateStatus(repoName, sha string, status *github.RepoStatus) (*github.RepoStatus, error)
+	CreateStatusBatch(repoName string, shas []string, status *github.RepoStatus, concurrency int) map[string]error
+	ExportTree(repoName, dirPath, ref string) (map[string][]byte, error)
+	CreateCommit(repoName, message, treeSHA string, parents []string) (*github.Commit, error)
+	UpdateRef(repoName, ref, sha string, force bool) (*github.Reference, error)
+	CreateBranch(repoName, newBranch, fromSHA string) (*github.Reference, error)
+	DefaultBranch(repoName string) (string, error)
+	SearchRepositories(query string, opts ...SearchOption) []*github.Repository
+	SearchCode(query string) []*github.CodeResult
 	optsPullRequest(subject, srcBranch, dstBranch, description string) *github.NewPullRequest
 }
 
 // Client encapsulate in a more simply implementation the Google's go-github
+//
+// A Client is safe for concurrent use by multiple goroutines once configured. Its exported fields, like
+// Organization or AllPages, are read by every call and must not be changed while the client is shared;
+// to vary them per call change them on a copy, for instance client.WithContext(ctx), which keeps sharing
+// the transport and its guarded state (rate limits, retry policy, ETag cache) with the original client
 type Client struct {
-	Organization string
-	AllPages     bool
-	token        string
-	github       *github.Client
-	ctx          context.Context
-	tkSource     oauth2.TokenSource
-	tClient      *http.Client
+	Organization        string
+	AllPages            bool
+	PerPage             int
+	PageDelay           time.Duration
+	SquashTitleTemplate string
+	CreateMissingLabels bool
+	BaseDir             string
+	// CommitAuthor and CommitCommitter, when set, sign the commits created by this client
+	// instead of the authenticated user
+	CommitAuthor    *github.CommitAuthor
+	CommitCommitter *github.CommitAuthor
+	token           string
+	github          *github.Client
+	ctx             context.Context
+	tkSource        oauth2.TokenSource
+	tClient         *http.Client
+	plainClient     *http.Client
+	retry           *retryTransport
+	retryPolicy     RetryPolicy
+	writes          chan struct{}
+	rate            *rateTransport
+	cache           *cacheTransport
+	parallelPages   int
+	baseURL         string
+	httpClient      *http.Client
 }
 
-// New creates a github Client with a provided token
-func New(token string) *Client {
+// New creates a github Client with a provided token, configured by opts
+func New(token string, opts ...Option) *Client {
 
 	client := &Client{token: token, ctx: context.Background()}
-	client.tkSource = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: client.token})
-	client.tClient = oauth2.NewClient(client.ctx, client.tkSource)
-	client.github = github.NewClient(client.tClient)
 	client.AllPages = false
+	client.PerPage = maxPerPage
+	client.writes = make(chan struct{}, writeConcurrency)
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if client.tkSource == nil {
+		client.tkSource = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: client.token})
+	}
+	// a copy keeps the caller client timeouts, redirect policy and cookies untouched
+	var plain http.Client
+	if client.httpClient != nil {
+		plain = *client.httpClient
+	}
+	if plain.Transport == nil {
+		plain.Transport = http.DefaultTransport
+	}
+	client.rate = &rateTransport{base: plain.Transport}
+	client.retry = &retryTransport{base: client.rate, policy: client.retryPolicy}
+	plain.Transport = client.retry
+	// plainClient retries and tracks like tClient without the token, for presigned storage URLs
+	client.plainClient = &plain
+
+	tClient := plain
+	tClient.Transport = &oauth2.Transport{Source: oauth2.ReuseTokenSource(nil, client.tkSource), Base: client.retry}
+	client.tClient = &tClient
+	if client.cache != nil {
+		client.cache.base = client.tClient.Transport
+		client.tClient.Transport = client.cache
+	}
 
+	if len(client.baseURL) == 0 {
+		client.github = github.NewClient(client.tClient)
+	} else if enterprise, err := github.NewEnterpriseClient(client.baseURL, client.baseURL, client.tClient); err == nil {
+		client.github = enterprise
+	} else {
+		// never fall back to github.com with the Enterprise token, every request fails with err instead
+		client.github = github.NewClient(&http.Client{Transport: failingTransport{err: err}})
+	}
+	if client.cache != nil {
+		client.cache.api = client.github
+	}
 	return client
 }
 
 // Commit returns an Object Commit based on repoName and commitSHA
 func (c *Client) Commit(repoName, commitSHA string) *github.Commit {
 
-	if commit, _, err := c.github.Git.GetCommit(c.ctx, c.Organization, repoName, commitSHA); err == nil {
-		return commit
+	commit, _ := c.CommitE(repoName, commitSHA)
+	return commit
+}
+
+// CommitE returns an Object Commit based on repoName and commitSHA, failures are returned as go-github reported
+// them so a missing commit can be told apart with errors.As against *github.ErrorResponse
+func (c *Client) CommitE(repoName, commitSHA string) (*github.Commit, error) {
+
+	commit, _, err := c.github.Git.GetCommit(c.ctx, c.Organization, repoName, commitSHA)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return commit, nil
 }
 
 // Compare returns an Object Commit based on repoName and commitSHA
@@ -91,21 +294,20 @@ func (c *Client) Merge(repoName, base, head, message string) *github.RepositoryC
 func (c *Client) Repositories(repoType, repoSort string) []*github.Repository {
 
 	//
-	opts := &github.RepositoryListByOrgOptions{Type: repoType, Sort: repoSort, ListOptions: github.ListOptions{PerPage: 128, Page: 0}}
-
-	var repos []*github.Repository
-	for {
-		repo, response, err := c.github.Repositories.ListByOrg(c.ctx, c.Organization, opts)
-		if err != nil {
-			return nil
-		}
+	opts := github.RepositoryListByOrgOptions{Type: repoType, Sort: repoSort, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
 
-		repos = append(repos, repo...)
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Repositories.ListByOrg(c.ctx, c.Organization, &pageOpts)
+	})
+	if err != nil {
+		return nil
+	}
 
-		if response.NextPage == 0 || !c.AllPages {
-			break
-		}
-		opts.Page = response.NextPage
+	var repos []*github.Repository
+	for _, page := range pages {
+		repos = append(repos, page.([]*github.Repository)...)
 	}
 	return repos
 }
@@ -122,24 +324,37 @@ func (c *Client) Repository(repoName string) *github.Repository {
 // Branches returns all branches for a repoName
 func (c *Client) Branches(repoName string) []*github.Branch {
 
-	//
-	opts := &github.BranchListOptions{Protected: nil, ListOptions: github.ListOptions{PerPage: 4, Page: 0}}
+	branches, err := c.BranchesE(repoName)
+	if err != nil && err != ErrEmptyRepository {
+		return nil
+	}
+	return branches
+}
 
-	var branches []*github.Branch
-	for {
-		branch, response, err := c.github.Repositories.ListBranches(c.ctx, c.Organization, repoName, opts)
-		if err != nil {
-			return nil
-		}
+// BranchesE returns all branches for a repoName, an empty slice and ErrEmptyRepository when it has no commits yet
+func (c *Client) BranchesE(repoName string) ([]*github.Branch, error) {
+
+	//
+	opts := github.BranchListOptions{Protected: nil, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
 
-		branches = append(branches, branch...)
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Repositories.ListBranches(c.ctx, c.Organization, repoName, &pageOpts)
+	})
 
-		if response.NextPage == 0 || !c.AllPages {
-			break
+	branches := make([]*github.Branch, 0)
+	if err != nil {
+		if err = emptyRepository(err); err == ErrEmptyRepository {
+			return branches, err
 		}
-		opts.Page = response.NextPage
+		return nil, err
 	}
-	return branches
+
+	for _, page := range pages {
+		branches = append(branches, page.([]*github.Branch)...)
+	}
+	return branches, nil
 }
 
 // Branch returns an Object branch based on repoName and branchName
@@ -156,30 +371,43 @@ func (c *Client) Branch(repoName, branchName string) *github.Branch {
 
 // Tags returns all tags for a repoName
 func (c *Client) Tags(repoName string) []*github.RepositoryTag {
-	//
-	opts := &github.ListOptions{PerPage: 12, Page: 0}
 
-	var tags []*github.RepositoryTag
-	for {
-		tag, response, err := c.github.Repositories.ListTags(c.ctx, c.Organization, repoName, opts)
-		if err != nil {
-			return nil
-		}
+	tags, err := c.TagsE(repoName)
+	if err != nil && err != ErrEmptyRepository {
+		return nil
+	}
+	return tags
+}
 
-		tags = append(tags, tag...)
+// TagsE returns all tags for a repoName, an empty slice and ErrEmptyRepository when it has no commits yet
+func (c *Client) TagsE(repoName string) ([]*github.RepositoryTag, error) {
+	//
+	opts := github.ListOptions{PerPage: c.perPage(), Page: 0}
 
-		if response.NextPage == 0 || !c.AllPages {
-			break
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Repositories.ListTags(c.ctx, c.Organization, repoName, &pageOpts)
+	})
+
+	tags := make([]*github.RepositoryTag, 0)
+	if err != nil {
+		if err = emptyRepository(err); err == ErrEmptyRepository {
+			return tags, err
 		}
-		opts.Page = response.NextPage
+		return nil, err
 	}
-	return tags
+
+	for _, page := range pages {
+		tags = append(tags, page.([]*github.RepositoryTag)...)
+	}
+	return tags, nil
 }
 
 // TagByName returns an Object Tag based in repoName and tagName
 func (c *Client) TagByName(repoName, tagName string) *github.RepositoryTag {
 	//
-	opts := &github.ListOptions{PerPage: 128, Page: 0}
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
 
 	var theTag *github.RepositoryTag
 	for {
@@ -198,18 +426,20 @@ func (c *Client) TagByName(repoName, tagName string) *github.RepositoryTag {
 		if response.NextPage == 0 || theTag != nil {
 			break
 		}
+		if err = c.pause(); err != nil {
+			return nil
+		}
 		opts.Page = response.NextPage
 	}
 	return theTag
 }
 
 // ReferenceByBranch returns an Object Reference based in repoName and branchName
+//
+// Deprecated: branches live under refs/heads, use ReferenceByHeads
 func (c *Client) ReferenceByBranch(repoName, branchName string) *github.Reference {
 
-	if ref, _, err := c.github.Git.GetRef(c.ctx, c.Organization, repoName, "refs/branch/"+branchName); err == nil {
-		return ref
-	}
-	return nil
+	return c.ReferenceByHeads(repoName, branchName)
 }
 
 // ReferenceByHeads returns an Object Reference based in repoName and branchName from heads
@@ -240,7 +470,8 @@ func (c *Client) CreateRefs(repoName, branchName, SHARef string) *github.Referen
 	return nil
 }
 
-// Tree permits create an Object Tree given a fileName list
+// Tree permits create an Object Tree given a fileName list, files are read relative to BaseDir
+// while their path in the tree stays as given. Executables and symlinks keep their mode
 func (c *Client) Tree(repoName, sourceFiles string, reference *github.Reference) *github.Tree {
 
 	// Create a tree with what to commit.
@@ -248,14 +479,14 @@ func (c *Client) Tree(repoName, sourceFiles string, reference *github.Reference)
 
 	// Load each file into the tree.
 	for _, fileArg := range strings.Split(sourceFiles, ",") {
-		content := utilities.ReadFile(fileArg)
-		if content == nil {
+		entry := localTreeEntry(c.BaseDir, fileArg)
+		if entry == nil {
 			return nil
 		}
-		entries = append(entries, &github.TreeEntry{Path: github.String(fileArg), Type: github.String("blob"), Content: github.String(string(content)), Mode: github.String("100644")})
+		entries = append(entries, entry)
 	}
 
-	if tree, _, err := c.github.Git.CreateTree(c.ctx, c.Organization, repoName, *reference.Object.SHA, entries); err == nil {
+	if tree, err := c.createTree(repoName, entries, reference); err == nil {
 		return tree
 	}
 	return nil
@@ -264,21 +495,20 @@ func (c *Client) Tree(repoName, sourceFiles string, reference *github.Reference)
 // Users returns all Users in an Organization
 func (c *Client) Users() []*github.User {
 	//
-	opts := &github.UserListOptions{Since: 0, ListOptions: github.ListOptions{PerPage: 100, Page: 0}}
+	opts := github.UserListOptions{Since: 0, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
 
-	var users []*github.User
-	for {
-		user, response, err := c.github.Users.ListAll(c.ctx, opts)
-		if err != nil {
-			return nil
-		}
-
-		users = append(users, user...)
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Users.ListAll(c.ctx, &pageOpts)
+	})
+	if err != nil {
+		return nil
+	}
 
-		if response.NextPage == 0 || !c.AllPages {
-			break
-		}
-		opts.Page = response.NextPage
+	var users []*github.User
+	for _, page := range pages {
+		users = append(users, page.([]*github.User)...)
 	}
 	return users
 }
@@ -299,8 +529,15 @@ func (c *Client) User(userName string) *github.User {
 // CreatePullRequest permits create an PullRequest into repoName using source and destiny branches
 func (c *Client) CreatePullRequest(repoName, srcBranch, dstBranch, subject, description string) *github.PullRequest {
 
+	pr, _ := c.CreatePullRequestE(repoName, srcBranch, dstBranch, subject, description)
+	return pr
+}
+
+// CreatePullRequestE is CreatePullRequest returning the reason of a failure, like an already existing pull request
+func (c *Client) CreatePullRequestE(repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, error) {
+
 	if len(repoName) == 0 || len(srcBranch) == 0 || len(subject) == 0 {
-		return nil
+		return nil, fmt.Errorf("repo, source branch and subject cannot be null nor empty")
 	}
 
 	if strings.Contains(srcBranch, ":") && len(c.Organization) == 0 {
@@ -308,24 +545,39 @@ func (c *Client) CreatePullRequest(repoName, srcBranch, dstBranch, subject, desc
 	}
 
 	newPR := c.optsPullRequest(subject, srcBranch, dstBranch, description)
-	if pr, _, err := c.github.PullRequests.Create(c.ctx, c.Organization, repoName, newPR); err == nil {
-		return pr
-	} else {
-		panic(err)
+	pr, _, err := c.github.PullRequests.Create(c.ctx, c.Organization, repoName, newPR)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return pr, nil
 }
 
-// AssignReviewers permits assign Reviewers to an one PullRequest
+// AssignReviewers permits assign Reviewers to an one PullRequest, only those not already requested are asked
 func (c *Client) AssignReviewers(id int, repoName string, reviewers []string) *github.PullRequest {
 
+	pr, _ := c.AssignReviewersE(id, repoName, reviewers)
+	return pr
+}
+
+// AssignReviewersE is AssignReviewers returning the reason of a failure, like a 422 for an unknown reviewer
+func (c *Client) AssignReviewersE(id int, repoName string, reviewers []string) (*github.PullRequest, error) {
+
 	if len(reviewers) == 0 {
-		return nil
+		return nil, fmt.Errorf("reviewers cannot be null nor empty")
 	}
 
-	rr := github.ReviewersRequest{Reviewers: reviewers, TeamReviewers: nil}
+	return c.requestReviewers(id, repoName, reviewers, nil, false)
+}
+
+// RerequestReviewers asks again for the review of reviewers on an one PullRequest, even when already requested.
+// It is AssignReviewers forced, kept apart so AssignReviewers keeps its signature
+func (c *Client) RerequestReviewers(id int, repoName string, reviewers []string) *github.PullRequest {
+
+	if len(reviewers) == 0 {
+		return nil
+	}
 
-	if pr, _, err := c.github.PullRequests.RequestReviewers(c.ctx, c.Organization, repoName, id, rr); err == nil {
+	if pr, err := c.requestReviewers(id, repoName, reviewers, nil, true); err == nil {
 		return pr
 	}
 	return nil
@@ -355,10 +607,18 @@ func (c *Client) Download(repoName, refName, filePath string) (body io.ReadClose
 		if contents.Type != nil && *contents.Type == "file" {
 			if contents.Name != nil && *contents.Name == fileName {
 
+				var request *http.Request
+				if request, err = http.NewRequestWithContext(c.ctx, http.MethodGet, contents.GetDownloadURL(), nil); err != nil {
+					return nil, err
+				}
 				var response *http.Response
-				if response, err = http.Get(*contents.DownloadURL); err != nil {
+				if response, err = c.tClient.Do(request); err != nil {
 					return nil, err
 				}
+				if response.StatusCode != http.StatusOK {
+					response.Body.Close()
+					return nil, fmt.Errorf("download of %s failed: %s", filePath, response.Status)
+				}
 				return response.Body, nil
 			}
 		}
@@ -366,6 +626,53 @@ func (c *Client) Download(repoName, refName, filePath string) (body io.ReadClose
 	return nil, fmt.Errorf("filename %s not found", fileName)
 }
 
+// acquireWrite waits for a slot of the write semaphore shared by the client and its copies, and returns the
+// function releasing it. It fails with the context error when cancelled while waiting
+func (c *Client) acquireWrite() (func(), error) {
+
+	select {
+	case <-c.ctx.Done():
+		return nil, c.ctx.Err()
+	case c.writes <- struct{}{}:
+		return func() { <-c.writes }, nil
+	}
+}
+
+// pause waits PageDelay, plus a small random jitter, between two paged requests
+// returning early with the context error when it is cancelled
+func (c *Client) pause() error {
+
+	if c.PageDelay <= 0 {
+		return nil
+	}
+	return c.sleep(c.PageDelay + time.Duration(rand.Int63n(int64(c.PageDelay)/10+1)))
+}
+
+// sleep waits for delay or until the client context is done, whichever happens first
+func (c *Client) sleep(delay time.Duration) error {
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// do sends a request to the GitHub API endpoint urlStr, relative to the API base URL, for the
+// endpoints go-github does not wrap yet. The JSON response is decoded into v when not nil
+func (c *Client) do(method, urlStr string, body, v interface{}) (*github.Response, error) {
+
+	request, err := c.github.NewRequest(method, urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.github.Do(c.ctx, request, v)
+}
+
 // optsPullRequest populate a NewPullRequests with its info
 func (c *Client) optsPullRequest(subject, srcBranch, dstBranch, description string) *github.NewPullRequest {
 
@@ -1,13 +1,168 @@
 package git
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-github/v32/github"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
 	"testing"
 )
 
+// setup returns a Client for the "org" Organization talking to a fake GitHub API served by mux
+func setup() (client *Client, mux *http.ServeMux, teardown func()) {
+
+	mux = http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	client = New("")
+	client.Organization = "org"
+	client.github.BaseURL, _ = url.Parse(server.URL + "/")
+
+	return client, mux, server.Close
+}
+
 func TestNew(t *testing.T) {
 	client := New("")
 
 	assert.NotNil(t, client)
 	assert.NotNil(t, client.github)
 }
+
+func TestBranchesEmptyRepository(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	empty := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"Git Repository is empty."}`))
+	}
+	mux.HandleFunc("/repos/org/empty/branches", empty)
+	mux.HandleFunc("/repos/org/empty/tags", empty)
+
+	branches, err := client.BranchesE("empty")
+	assert.Equal(t, ErrEmptyRepository, err)
+	assert.NotNil(t, branches)
+	assert.Empty(t, branches)
+
+	tags, err := client.TagsE("empty")
+	assert.Equal(t, ErrEmptyRepository, err)
+	assert.NotNil(t, tags)
+	assert.Empty(t, tags)
+
+	assert.NotNil(t, client.Branches("empty"))
+}
+
+func TestBranchesFailure(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/broken/branches", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	branches, err := client.BranchesE("broken")
+	assert.Error(t, err)
+	assert.NotEqual(t, ErrEmptyRepository, err)
+	assert.Nil(t, branches)
+	assert.Nil(t, client.Branches("broken"))
+}
+
+func TestTreeFromBaseDir(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	baseDir, err := ioutil.TempDir("", "git-tree")
+	assert.NoError(t, err)
+	defer os.RemoveAll(baseDir)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(baseDir, "config"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(baseDir, "config", "app.yaml"), []byte("replicas: 3\n"), 0644))
+
+	var body struct {
+		BaseTree string              `json:"base_tree"`
+		Entries  []*github.TreeEntry `json:"tree"`
+	}
+	mux.HandleFunc("/repos/org/repo/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprint(w, `{"sha":"tree-sha"}`)
+	})
+
+	client.BaseDir = baseDir
+	reference := &github.Reference{Object: &github.GitObject{SHA: github.String("base-sha")}}
+	tree := client.Tree("repo", "config/app.yaml", reference)
+
+	assert.NotNil(t, tree)
+	assert.Equal(t, "base-sha", body.BaseTree)
+	if assert.Len(t, body.Entries, 1) {
+		assert.Equal(t, "config/app.yaml", body.Entries[0].GetPath())
+		assert.Equal(t, "replicas: 3\n", body.Entries[0].GetContent())
+	}
+}
+
+func TestReferenceByBranch(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/git/ref/heads/feature", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ref":"refs/heads/feature","object":{"type":"commit","sha":"abc"}}`))
+	})
+
+	ref := client.ReferenceByBranch("repo", "feature")
+	if assert.NotNil(t, ref) {
+		assert.Equal(t, "refs/heads/feature", ref.GetRef())
+		assert.Equal(t, "abc", ref.GetObject().GetSHA())
+	}
+}
+
+func TestClientConcurrentUse(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.AllPages = true
+	client.parallelPages = 2
+	paged := func(item string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page == 0 {
+				page = 1
+			}
+			w.Header().Set("X-RateLimit-Limit", "5000")
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(5000-page))
+			w.Header().Set("X-RateLimit-Reset", "1700000000")
+			if page < 3 {
+				w.Header().Set("Link", fmt.Sprintf(`<%s?page=%d>; rel="next", <%s?page=3>; rel="last"`, r.URL.Path, page+1, r.URL.Path))
+			}
+			fmt.Fprintf(w, `[{"name":"%s-%d"}]`, item, page)
+		}
+	}
+	mux.HandleFunc("/orgs/org/repos", paged("repo"))
+	mux.HandleFunc("/repos/org/repo/branches", paged("branch"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			if i%4 == 0 {
+				client.SetRetryPolicy(RetryPolicy{MaxRetries: i})
+				_ = client.LastRate()
+			}
+			assert.Len(t, client.Repositories("all", ""), 3)
+			branches := client.WithContext(context.Background()).Branches("repo")
+			if assert.Len(t, branches, 3) {
+				assert.Equal(t, "branch-1", branches[0].GetName())
+			}
+		}(i)
+	}
+	wg.Wait()
+}
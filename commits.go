@@ -0,0 +1,245 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ResolveRef returns the commit SHA that ref, a branch, tag or SHA, points to in repoName
+func (c *Client) ResolveRef(repoName, ref string) (string, error) {
+
+	sha, _, err := c.github.Repositories.GetCommitSHA1(c.ctx, c.Organization, repoName, ref, "")
+	if err != nil {
+		if statusCode(err) == http.StatusUnprocessableEntity {
+			return "", ErrNotFound
+		}
+		return "", notFound(err)
+	}
+	return sha, nil
+}
+
+// CommitByRef returns the full commit, stats and files included, that ref (a branch, tag or SHA) points to
+func (c *Client) CommitByRef(repoName, ref string) (*github.RepositoryCommit, error) {
+
+	sha, err := c.ResolveRef(repoName, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, _, err := c.github.Repositories.GetCommit(c.ctx, c.Organization, repoName, sha)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return commit, nil
+}
+
+// Revert commits on branch the inverse of the commit commitSHA and advances branch to it, GitHub having no
+// revert API the inverse tree is computed from the changed files. ErrMergeConflict is returned when any of
+// those files changed on branch since, merge commits cannot be reverted
+func (c *Client) Revert(repoName, commitSHA, branch string) (*github.RepositoryCommit, error) {
+
+	commit, _, err := c.github.Repositories.GetCommit(c.ctx, c.Organization, repoName, commitSHA)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if len(commit.Parents) != 1 {
+		return nil, fmt.Errorf("commit %s has %d parents, only single parent commits can be reverted", commitSHA, len(commit.Parents))
+	}
+
+	headSHA, err := c.BranchSHA(repoName, branch)
+	if err != nil {
+		return nil, err
+	}
+	head, _, err := c.github.Git.GetCommit(c.ctx, c.Organization, repoName, headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	headFiles, err := c.treeFiles(repoName, head.GetTree().GetSHA())
+	if err != nil {
+		return nil, err
+	}
+	parentFiles, err := c.treeFiles(repoName, commit.Parents[0].GetSHA())
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := revertEntries(commit.Files, headFiles, parentFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, _, err := c.github.Git.CreateTree(c.ctx, c.Organization, repoName, head.GetTree().GetSHA(), entries)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := strings.SplitN(commit.GetCommit().GetMessage(), "\n", 2)[0]
+	message := fmt.Sprintf("Revert \"%s\"\n\nThis reverts commit %s.", subject, commit.GetSHA())
+	revert, err := c.createCommit(repoName, message, tree.GetSHA(), []string{headSHA})
+	if err != nil {
+		return nil, err
+	}
+
+	ref := &github.Reference{Ref: github.String("refs/heads/" + branch), Object: &github.GitObject{SHA: revert.SHA}}
+	if _, _, err = c.github.Git.UpdateRef(c.ctx, c.Organization, repoName, ref, false); err != nil {
+		if statusCode(err) == http.StatusUnprocessableEntity {
+			return nil, fmt.Errorf("branch %s moved while reverting %s: %w", branch, commitSHA, ErrMergeConflict)
+		}
+		return nil, err
+	}
+
+	result, _, err := c.github.Repositories.GetCommit(c.ctx, c.Organization, repoName, revert.GetSHA())
+	return result, err
+}
+
+// revertEntries returns the tree entries undoing files on top of a tree holding headFiles, restoring the
+// blobs of parentFiles. A file whose content on head differs from the one files left is a conflict
+func revertEntries(files []*github.CommitFile, headFiles, parentFiles map[string]*github.TreeEntry) ([]*github.TreeEntry, error) {
+
+	conflict := func(name string) error {
+		return fmt.Errorf("%s changed since the reverted commit: %w", name, ErrMergeConflict)
+	}
+	remove := func(name string) *github.TreeEntry {
+		return &github.TreeEntry{Path: github.String(name), Mode: headFiles[name].Mode, Type: github.String("blob")}
+	}
+	restore := func(name string) (*github.TreeEntry, error) {
+		parent, ok := parentFiles[name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing from the parent commit", name)
+		}
+		return &github.TreeEntry{Path: github.String(name), Mode: parent.Mode, Type: github.String("blob"), SHA: parent.SHA}, nil
+	}
+
+	var entries []*github.TreeEntry
+	for _, file := range files {
+		name := file.GetFilename()
+		current, exists := headFiles[name]
+
+		if file.GetStatus() == "removed" {
+			if exists {
+				return nil, conflict(name)
+			}
+		} else if !exists || current.GetSHA() != file.GetSHA() {
+			return nil, conflict(name)
+		}
+
+		switch file.GetStatus() {
+		case "added":
+			entries = append(entries, remove(name))
+		case "renamed":
+			previous := file.GetPreviousFilename()
+			if _, taken := headFiles[previous]; taken {
+				return nil, conflict(previous)
+			}
+			entry, err := restore(previous)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, remove(name), entry)
+		default:
+			entry, err := restore(name)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// treeFiles returns the blobs of the recursive tree treeSHA, a commit SHA is accepted too, keyed by path
+func (c *Client) treeFiles(repoName, treeSHA string) (map[string]*github.TreeEntry, error) {
+
+	tree, _, err := c.github.Git.GetTree(c.ctx, c.Organization, repoName, treeSHA, true)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if tree.GetTruncated() {
+		return nil, fmt.Errorf("tree %s is too large to be read at once", treeSHA)
+	}
+
+	files := make(map[string]*github.TreeEntry, len(tree.Entries))
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" {
+			files[entry.GetPath()] = entry
+		}
+	}
+	return files, nil
+}
+
+// CreateCommit creates a commit of treeSHA on top of parents, none for a root commit and several for a merge
+// commit, signed by CommitAuthor and CommitCommitter when set. No branch moves until UpdateRef is called
+func (c *Client) CreateCommit(repoName, message, treeSHA string, parents []string) (*github.Commit, error) {
+
+	return c.createCommit(repoName, message, treeSHA, parents)
+}
+
+// UpdateRef points ref of repoName, like "heads/feature" or "refs/heads/feature", to sha. Unless force is set
+// the update must be a fast forward, otherwise GitHub refuses it with a 422
+func (c *Client) UpdateRef(repoName, ref, sha string, force bool) (*github.Reference, error) {
+
+	if !strings.HasPrefix(ref, "refs/") {
+		ref = "refs/" + ref
+	}
+
+	updated, _, err := c.github.Git.UpdateRef(c.ctx, c.Organization, repoName, &github.Reference{Ref: github.String(ref), Object: &github.GitObject{SHA: github.String(sha)}}, force)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return updated, nil
+}
+
+// createCommit creates a commit of treeSHA on top of parents signed by CommitAuthor and CommitCommitter when set
+func (c *Client) createCommit(repoName, message, treeSHA string, parents []string) (*github.Commit, error) {
+
+	commit := &github.Commit{
+		Message:   github.String(message),
+		Tree:      &github.Tree{SHA: github.String(treeSHA)},
+		Author:    c.CommitAuthor,
+		Committer: c.CommitCommitter,
+	}
+	for _, parent := range parents {
+		commit.Parents = append(commit.Parents, &github.Commit{SHA: github.String(parent)})
+	}
+
+	created, _, err := c.github.Git.CreateCommit(c.ctx, c.Organization, repoName, commit)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// CommitsByAuthor returns the commits of repoName since since whose author email is authorEmail, newest first.
+// The API author filter also matches logins and loose emails, so the email is checked again on every commit
+func (c *Client) CommitsByAuthor(repoName, authorEmail string, since time.Time) ([]*github.RepositoryCommit, error) {
+	//
+	opts := github.CommitsListOptions{Author: authorEmail, Since: since, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Repositories.ListCommits(c.ctx, c.Organization, repoName, &pageOpts)
+	})
+	if err != nil {
+		return nil, emptyRepository(notFound(err))
+	}
+
+	var commits []*github.RepositoryCommit
+	for _, page := range pages {
+		for _, commit := range page.([]*github.RepositoryCommit) {
+			if strings.EqualFold(commit.GetCommit().GetAuthor().GetEmail(), authorEmail) {
+				commits = append(commits, commit)
+			}
+		}
+	}
+
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].GetCommit().GetAuthor().GetDate().After(commits[j].GetCommit().GetAuthor().GetDate())
+	})
+	return commits, nil
+}
@@ -0,0 +1,98 @@
+package git
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func blob(path, sha string) *github.TreeEntry {
+	return &github.TreeEntry{Path: github.String(path), SHA: github.String(sha), Mode: github.String("100644"), Type: github.String("blob")}
+}
+
+func TestRevertEntries(t *testing.T) {
+	files := []*github.CommitFile{
+		{Filename: github.String("new.txt"), Status: github.String("added"), SHA: github.String("n1")},
+		{Filename: github.String("main.go"), Status: github.String("modified"), SHA: github.String("m2")},
+		{Filename: github.String("old.txt"), Status: github.String("removed"), SHA: github.String("o1")},
+		{Filename: github.String("b.txt"), Status: github.String("renamed"), SHA: github.String("r1"), PreviousFilename: github.String("a.txt")},
+	}
+	parent := map[string]*github.TreeEntry{"main.go": blob("main.go", "m1"), "old.txt": blob("old.txt", "o1"), "a.txt": blob("a.txt", "r1")}
+	head := map[string]*github.TreeEntry{"main.go": blob("main.go", "m2"), "new.txt": blob("new.txt", "n1"), "b.txt": blob("b.txt", "r1")}
+
+	entries, err := revertEntries(files, head, parent)
+	assert.NoError(t, err)
+
+	reverted := make(map[string]*string)
+	for _, entry := range entries {
+		reverted[entry.GetPath()] = entry.SHA
+	}
+	assert.Len(t, reverted, 5)
+	assert.Nil(t, reverted["new.txt"])
+	assert.Equal(t, "m1", *reverted["main.go"])
+	assert.Equal(t, "o1", *reverted["old.txt"])
+	assert.Nil(t, reverted["b.txt"])
+	assert.Equal(t, "r1", *reverted["a.txt"])
+
+	head["main.go"] = blob("main.go", "m3")
+	_, err = revertEntries(files, head, parent)
+	assert.True(t, errors.Is(err, ErrMergeConflict))
+}
+
+func TestCommitENotFound(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/git/commits/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+	})
+
+	commit, err := client.CommitE("repo", "missing")
+	assert.Nil(t, commit)
+	var errResponse *github.ErrorResponse
+	assert.True(t, errors.As(err, &errResponse))
+	assert.Equal(t, http.StatusNotFound, errResponse.Response.StatusCode)
+	assert.Nil(t, client.Commit("repo", "missing"))
+}
+
+func TestCreateMergeCommitAndUpdateRef(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var commit struct {
+		Message string   `json:"message"`
+		Tree    string   `json:"tree"`
+		Parents []string `json:"parents"`
+	}
+	mux.HandleFunc("/repos/org/repo/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&commit)
+		fmt.Fprint(w, `{"sha":"m1","parents":[{"sha":"p1"},{"sha":"p2"}]}`)
+	})
+	var update struct {
+		SHA   string `json:"sha"`
+		Force bool   `json:"force"`
+	}
+	mux.HandleFunc("/repos/org/repo/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&update)
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"m1"}}`)
+	})
+
+	merge, err := client.CreateCommit("repo", "Merge feature", "t1", []string{"p1", "p2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "m1", merge.GetSHA())
+	assert.Len(t, merge.Parents, 2)
+	assert.Equal(t, "t1", commit.Tree)
+	assert.Equal(t, []string{"p1", "p2"}, commit.Parents)
+
+	ref, err := client.UpdateRef("repo", "heads/main", merge.GetSHA(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, "m1", ref.GetObject().GetSHA())
+	assert.Equal(t, "m1", update.SHA)
+	assert.False(t, update.Force)
+}
@@ -0,0 +1,58 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+)
+
+// compareMaxFiles is the most changed files GitHub lists in a comparison, a comparison holding that many may
+// have been cut
+const compareMaxFiles = 300
+
+// CompareAll compares base and head of repoName like Compare, but follows the pages of the comparison so every
+// commit between them is returned. GitHub lists the changed files on the first page only, at most 300 of them
+func (c *Client) CompareAll(repoName, base, head string) (*github.CommitsComparison, error) {
+
+	var comparison *github.CommitsComparison
+	for page := 1; page != 0; {
+		pageComparison := new(github.CommitsComparison)
+		u := fmt.Sprintf("repos/%s/%s/compare/%s...%s?per_page=%d&page=%d", c.Organization, repoName, base, head, c.perPage(), page)
+		response, err := c.do("GET", u, nil, pageComparison)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		if comparison == nil {
+			comparison = pageComparison
+		} else {
+			comparison.Commits = append(comparison.Commits, pageComparison.Commits...)
+		}
+
+		if page = response.NextPage; page != 0 {
+			if err = c.pause(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return comparison, nil
+}
+
+// DiffStats returns how many files changed between base and head of repoName, and the lines added and deleted.
+// When GitHub cut the file list at compareMaxFiles the figures are lower bounds, returned along with an error
+// wrapping ErrComparisonTruncated
+func (c *Client) DiffStats(repoName, base, head string) (files, additions, deletions int, err error) {
+
+	comparison, err := c.CompareAll(repoName, base, head)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	for _, file := range comparison.Files {
+		additions += file.GetAdditions()
+		deletions += file.GetDeletions()
+	}
+	if len(comparison.Files) >= compareMaxFiles {
+		return len(comparison.Files), additions, deletions, fmt.Errorf("%w: %s...%s of %s changes more than %d files", ErrComparisonTruncated, base, head, repoName, compareMaxFiles)
+	}
+	return len(comparison.Files), additions, deletions, nil
+}
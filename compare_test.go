@@ -0,0 +1,54 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDiffStats(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/compare/main...feature", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"commits":[{"sha":"c3"}]}`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next", <%s?page=2>; rel="last"`, r.URL.Path, r.URL.Path))
+		_, _ = w.Write([]byte(`{"total_commits":3,"commits":[{"sha":"c1"},{"sha":"c2"}],
+			"files":[{"filename":"a.go","additions":10,"deletions":2},{"filename":"b.go","additions":1,"deletions":5}]}`))
+	})
+
+	comparison, err := client.CompareAll("repo", "main", "feature")
+	assert.NoError(t, err)
+	assert.Len(t, comparison.Commits, 3)
+
+	files, additions, deletions, err := client.DiffStats("repo", "main", "feature")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, files)
+	assert.Equal(t, 11, additions)
+	assert.Equal(t, 7, deletions)
+}
+
+func TestDiffStatsTruncated(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	files := make([]string, compareMaxFiles)
+	for i := range files {
+		files[i] = fmt.Sprintf(`{"filename":"f%d.go","additions":2,"deletions":1}`, i)
+	}
+	mux.HandleFunc("/repos/org/repo/compare/main...huge", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"total_commits":1,"commits":[{"sha":"c1"}],"files":[%s]}`, strings.Join(files, ","))
+	})
+
+	changed, additions, deletions, err := client.DiffStats("repo", "main", "huge")
+	assert.True(t, errors.Is(err, ErrComparisonTruncated))
+	assert.Equal(t, compareMaxFiles, changed)
+	assert.Equal(t, 2*compareMaxFiles, additions)
+	assert.Equal(t, compareMaxFiles, deletions)
+}
@@ -0,0 +1,132 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"unicode/utf8"
+)
+
+// binarySniffLength is how many leading bytes are inspected to tell binary from text content
+const binarySniffLength = 8000
+
+// FileInfo describes a file of a repository
+type FileInfo struct {
+	Path     string
+	SHA      string
+	Size     int
+	IsBinary bool
+}
+
+// FileMeta returns the size, blob SHA and binaryness of filePath in repoName at ref
+func (c *Client) FileMeta(repoName, filePath, ref string) (*FileInfo, error) {
+
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
+	file, _, _, err := c.github.Repositories.GetContents(c.ctx, c.Organization, repoName, filePath, opts)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if file == nil || file.GetType() != "file" {
+		return nil, fmt.Errorf("%s is not a file", filePath)
+	}
+
+	// files over 1MB come without content, read their blob instead
+	var content []byte
+	if file.Content != nil && *file.Content != "" {
+		decoded, err := file.GetContent()
+		if err != nil {
+			return nil, err
+		}
+		content = []byte(decoded)
+	} else if file.GetSize() > 0 {
+		if content, _, err = c.github.Git.GetBlobRaw(c.ctx, c.Organization, repoName, file.GetSHA()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &FileInfo{Path: file.GetPath(), SHA: file.GetSHA(), Size: file.GetSize(), IsBinary: isBinary(content)}, nil
+}
+
+// FileIsBinary reports whether filePath in repoName at ref holds binary content rather than text
+func (c *Client) FileIsBinary(repoName, filePath, ref string) (bool, error) {
+
+	info, err := c.FileMeta(repoName, filePath, ref)
+	if err != nil {
+		return false, err
+	}
+	return info.IsBinary, nil
+}
+
+// isBinary reports whether the beginning of content has a NUL byte or is not valid UTF-8
+func isBinary(content []byte) bool {
+
+	if len(content) > binarySniffLength {
+		content = content[:binarySniffLength]
+		// do not let a multi byte character cut at the limit count as invalid
+		for i := 0; i < utf8.UTFMax && len(content) > 0 && !utf8.Valid(content); i++ {
+			content = content[:len(content)-1]
+		}
+	}
+	return bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content)
+}
+
+// CreateFile commits the new file filePath with content on branch of repoName
+func (c *Client) CreateFile(repoName, branch, filePath, message string, content []byte) (*github.RepositoryContentResponse, error) {
+
+	opts := c.fileOptions(branch, message)
+	opts.Content = content
+	response, _, err := c.github.Repositories.CreateFile(c.ctx, c.Organization, repoName, filePath, opts)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return response, nil
+}
+
+// UpdateFile commits content as the new version of filePath on branch of repoName, sha is the blob SHA of the
+// version being replaced, so a file changed in between is not overwritten
+func (c *Client) UpdateFile(repoName, branch, filePath, message string, content []byte, sha string) (*github.RepositoryContentResponse, error) {
+
+	if len(sha) == 0 {
+		return nil, fmt.Errorf("sha of the replaced file cannot be null nor empty")
+	}
+
+	opts := c.fileOptions(branch, message)
+	opts.Content = content
+	opts.SHA = github.String(sha)
+	response, _, err := c.github.Repositories.UpdateFile(c.ctx, c.Organization, repoName, filePath, opts)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return response, nil
+}
+
+// DeleteFile commits the removal of filePath from branch of repoName, sha is the blob SHA of the removed version
+func (c *Client) DeleteFile(repoName, branch, filePath, message, sha string) (*github.RepositoryContentResponse, error) {
+
+	if len(sha) == 0 {
+		return nil, fmt.Errorf("sha of the deleted file cannot be null nor empty")
+	}
+
+	opts := c.fileOptions(branch, message)
+	opts.SHA = github.String(sha)
+	response, _, err := c.github.Repositories.DeleteFile(c.ctx, c.Organization, repoName, filePath, opts)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return response, nil
+}
+
+// fileOptions returns the options of a single file commit on branch, signed by CommitAuthor and CommitCommitter
+// when set
+func (c *Client) fileOptions(branch, message string) *github.RepositoryContentFileOptions {
+
+	opts := &github.RepositoryContentFileOptions{
+		Message:   github.String(message),
+		Author:    c.CommitAuthor,
+		Committer: c.CommitCommitter,
+	}
+	if len(branch) > 0 {
+		opts.Branch = github.String(branch)
+	}
+	return opts
+}
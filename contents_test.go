@@ -0,0 +1,55 @@
+package git
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestIsBinary(t *testing.T) {
+	assert.False(t, isBinary(nil))
+	assert.False(t, isBinary([]byte("package git\n")))
+	assert.False(t, isBinary([]byte("señal ✓")))
+	assert.True(t, isBinary([]byte{0x7f, 'E', 'L', 'F', 0, 1}))
+	assert.True(t, isBinary([]byte{0xff, 0xfe, 0xfd}))
+
+	// a multi byte character cut at the sniff limit is still text
+	long := append(bytes.Repeat([]byte("a"), binarySniffLength-1), []byte("✓")...)
+	assert.False(t, isBinary(long))
+}
+
+func TestFileOperations(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	client.CommitAuthor = &github.CommitAuthor{Name: github.String("bot"), Email: github.String("bot@example.com")}
+
+	var payloads []map[string]interface{}
+	mux.HandleFunc("/repos/org/repo/contents/VERSION", func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		payload["method"] = r.Method
+		payloads = append(payloads, payload)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"content":{"path":"VERSION","sha":"new"},"commit":{"sha":"c1"}}`))
+	})
+
+	created, err := client.CreateFile("repo", "main", "VERSION", "Add version", []byte("1.0.0\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "new", created.GetContent().GetSHA())
+	_, err = client.UpdateFile("repo", "main", "VERSION", "Bump version", []byte("1.0.1\n"), "old")
+	assert.NoError(t, err)
+	_, err = client.DeleteFile("repo", "", "VERSION", "Remove version", "new")
+	assert.NoError(t, err)
+	_, err = client.UpdateFile("repo", "main", "VERSION", "Bump version", []byte("1.0.2\n"), "")
+	assert.Error(t, err)
+
+	author := map[string]interface{}{"name": "bot", "email": "bot@example.com"}
+	assert.Equal(t, []map[string]interface{}{
+		{"method": "PUT", "message": "Add version", "content": "MS4wLjAK", "branch": "main", "author": author},
+		{"method": "PUT", "message": "Bump version", "content": "MS4wLjEK", "sha": "old", "branch": "main", "author": author},
+		{"method": "DELETE", "message": "Remove version", "sha": "new", "author": author},
+	}, payloads)
+}
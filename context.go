@@ -0,0 +1,1039 @@
+package git
+
+import (
+	"context"
+	"github.com/google/go-github/v32/github"
+	"io"
+	"time"
+)
+
+// OperationsContext mirrors the methods of Operations making API calls with an explicit context as their first
+// argument. Every XCtx is exactly c.WithContext(ctx).X(...), methods configuring the client like SetRetryPolicy
+// or WithRetryPolicy and the RepositoriesIter constructor have none: use WithContext directly for them
+type OperationsContext interface {
+	CommitCtx(ctx context.Context, repoName, commitSHA string) *github.Commit
+	CommitECtx(ctx context.Context, repoName, commitSHA string) (*github.Commit, error)
+	CompareCtx(ctx context.Context, repoName, base, head string) *github.CommitsComparison
+	MergeCtx(ctx context.Context, repoName, base, head, message string) *github.RepositoryCommit
+	RepositoriesCtx(ctx context.Context, repoType, repoSort string) []*github.Repository
+	RepositoryCtx(ctx context.Context, repoName string) *github.Repository
+	BranchesCtx(ctx context.Context, repoName string) []*github.Branch
+	BranchesECtx(ctx context.Context, repoName string) ([]*github.Branch, error)
+	BranchCtx(ctx context.Context, repoName, branchName string) *github.Branch
+	TagsCtx(ctx context.Context, repoName string) []*github.RepositoryTag
+	TagsECtx(ctx context.Context, repoName string) ([]*github.RepositoryTag, error)
+	TagByNameCtx(ctx context.Context, repoName, tagName string) *github.RepositoryTag
+	ReferenceByBranchCtx(ctx context.Context, repoName, branchName string) *github.Reference
+	ReferenceByHeadsCtx(ctx context.Context, repoName, branchName string) *github.Reference
+	ReferenceByTagCtx(ctx context.Context, repoName, tagName string) *github.Reference
+	CreateRefsCtx(ctx context.Context, repoName, branchName, SHARef string) *github.Reference
+	TreeCtx(ctx context.Context, repoName, sourceFiles string, reference *github.Reference) *github.Tree
+	TreeFromContentCtx(ctx context.Context, repoName string, files map[string][]byte, reference *github.Reference) (*github.Tree, error)
+	UsersCtx(ctx context.Context) []*github.User
+	UserCtx(ctx context.Context, userName string) *github.User
+	CreatePullRequestCtx(ctx context.Context, repoName, srcBranch, dstBranch, subject, description string) *github.PullRequest
+	CreatePullRequestECtx(ctx context.Context, repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, error)
+	AssignReviewersCtx(ctx context.Context, id int, repoName string, reviewers []string) *github.PullRequest
+	AssignReviewersECtx(ctx context.Context, id int, repoName string, reviewers []string) (*github.PullRequest, error)
+	RerequestReviewersCtx(ctx context.Context, id int, repoName string, reviewers []string) *github.PullRequest
+	DownloadCtx(ctx context.Context, repoName, refName, filePath string) (body io.ReadCloser, err error)
+	ImportProgressCtx(ctx context.Context, repoName string) (*github.Import, error)
+	StartImportCtx(ctx context.Context, repoName string, req *github.Import) (*github.Import, error)
+	GPGKeysCtx(ctx context.Context) ([]*github.GPGKey, error)
+	CreateGPGKeyCtx(ctx context.Context, armoredKey string) (*github.GPGKey, error)
+	DeleteGPGKeyCtx(ctx context.Context, id int64) error
+	MergePullRequestCtx(ctx context.Context, repoName string, number int, commitMessage string, method string) (*github.PullRequestMergeResult, error)
+	CheckSuitesCtx(ctx context.Context, repoName, ref string) ([]*github.CheckSuite, error)
+	RerequestCheckSuiteCtx(ctx context.Context, repoName string, suiteID int64) error
+	SubscribeCtx(ctx context.Context, repoName string, subscribed, ignored bool) (*github.Subscription, error)
+	UnsubscribeCtx(ctx context.Context, repoName string) error
+	PullRequestForBranchCtx(ctx context.Context, repoName, branch string) (*github.PullRequest, error)
+	ReleaseAssetsCtx(ctx context.Context, repoName string, releaseID int64) ([]*github.ReleaseAsset, error)
+	DeleteReleaseAssetCtx(ctx context.Context, repoName string, assetID int64) error
+	ProtectionDriftCtx(ctx context.Context, repoName, branch string, desired *github.ProtectionRequest) ([]string, error)
+	CreateRepositoryInitializedCtx(ctx context.Context, repoName string, private bool, gitignoreTemplate, licenseTemplate string) (*github.Repository, error)
+	LatestReleaseCtx(ctx context.Context, repoName string) (*github.RepositoryRelease, error)
+	LatestSemverTagCtx(ctx context.Context, repoName string) (string, error)
+	RepositorySummariesCtx(ctx context.Context, repoNames []string, concurrency int) (map[string]*RepoSummary, error)
+	WorkflowRunUsageCtx(ctx context.Context, repoName string, runID int64) (*github.WorkflowRunUsage, error)
+	WorkflowCostCtx(ctx context.Context, repoName string, workflowID int64, since time.Time) (map[string]int64, error)
+	PullRequestsAwaitingReviewCtx(ctx context.Context) ([]*github.PullRequest, error)
+	ClearDefaultLabelsCtx(ctx context.Context, repoName string) error
+	DownloadArchiveToCtx(ctx context.Context, repoName, ref string, format github.ArchiveFormat, w io.Writer, progress func(bytesWritten int64)) error
+	ResolveRefCtx(ctx context.Context, repoName, ref string) (string, error)
+	CommitByRefCtx(ctx context.Context, repoName, ref string) (*github.RepositoryCommit, error)
+	GenerateReleaseNotesCtx(ctx context.Context, repoName, tagName, previousTag string) (*ReleaseNotes, error)
+	MilestonesCtx(ctx context.Context, repoName, state string) []*github.Milestone
+	MilestoneProgressCtx(ctx context.Context, repoName string, number int) (percent float64, err error)
+	DeleteBranchCtx(ctx context.Context, repoName, branch string) error
+	DeleteBranchesMatchingCtx(ctx context.Context, repoName, pattern string, concurrency int, dryRun bool) ([]string, error)
+	SecretScanningAlertsCtx(ctx context.Context, repoName string, state string) ([]*SecretScanningAlert, error)
+	CodeScanningAlertsCtx(ctx context.Context, repoName, state string) ([]*github.Alert, error)
+	SBOMCtx(ctx context.Context, repoName string) (*SBOM, error)
+	CheckRunAnnotationsCtx(ctx context.Context, repoName string, checkRunID int64) ([]*github.CheckRunAnnotation, error)
+	EnsureLabelsCtx(ctx context.Context, repoName string, labels []*github.Label) error
+	FilesFromTreeCtx(ctx context.Context, repoName, ref string, paths []string) (map[string][]byte, error)
+	CreateIssueFromRequestCtx(ctx context.Context, repoName string, req *github.IssueRequest) (*github.Issue, error)
+	MergeQueueEntriesCtx(ctx context.Context, repoName, branch string) ([]MergeQueueEntry, error)
+	ClosePullRequestCtx(ctx context.Context, repoName string, number int) (*github.PullRequest, error)
+	ClosePullRequestWithReasonCtx(ctx context.Context, repoName string, number int, reason string) (*github.PullRequest, error)
+	ReopenPullRequestCtx(ctx context.Context, repoName string, number int) (*github.PullRequest, error)
+	ReopenIssueCtx(ctx context.Context, repoName string, number int) (*github.Issue, error)
+	AssignToLeastLoadedCtx(ctx context.Context, repoName string, number int, candidates []string) (*github.Issue, error)
+	ForkParentCtx(ctx context.Context, repoName string) (*github.Repository, error)
+	ForkSourceCtx(ctx context.Context, repoName string) (*github.Repository, error)
+	PendingDeploymentsCtx(ctx context.Context, repoName string, runID int64) ([]*PendingDeployment, error)
+	ApproveDeploymentCtx(ctx context.Context, repoName string, runID int64, envIDs []int64, comment string) error
+	BranchSHACtx(ctx context.Context, repoName, branch string) (string, error)
+	RepositoriesPushedSinceCtx(ctx context.Context, since time.Time) ([]*github.Repository, error)
+	DependabotSecretsCtx(ctx context.Context, repoName string) ([]*github.Secret, error)
+	CreateOrUpdateDependabotSecretCtx(ctx context.Context, repoName, name string, plaintext []byte) error
+	DeleteDependabotSecretCtx(ctx context.Context, repoName, name string) error
+	DownloadResumableCtx(ctx context.Context, repoName, ref, filePath string, w io.WriteSeeker) error
+	StatusesByCreatorCtx(ctx context.Context, repoName, ref string) (map[string][]*github.RepoStatus, error)
+	RevertCtx(ctx context.Context, repoName, commitSHA, branch string) (*github.RepositoryCommit, error)
+	FileMetaCtx(ctx context.Context, repoName, filePath, ref string) (*FileInfo, error)
+	FileIsBinaryCtx(ctx context.Context, repoName, filePath, ref string) (bool, error)
+	StartMigrationCtx(ctx context.Context, repoNames []string, lockRepositories bool) (*github.Migration, error)
+	MigrationStatusCtx(ctx context.Context, id int64) (*github.Migration, error)
+	DownloadMigrationArchiveCtx(ctx context.Context, id int64) (io.ReadCloser, error)
+	SetFeaturesCtx(ctx context.Context, repoName string, hasIssues, hasWiki, hasProjects, hasDiscussions *bool) (*github.Repository, error)
+	WaitForRefCtx(ctx context.Context, repoName, ref string, timeout time.Duration) (*github.Reference, error)
+	ReviewRequestAgesCtx(ctx context.Context, repoName string, number int) (map[string]time.Duration, error)
+	LabelInventoryCtx(ctx context.Context) (map[string]int, error)
+	GitignoreTemplatesCtx(ctx context.Context) ([]string, error)
+	GitignoreTemplateCtx(ctx context.Context, name string) (*github.Gitignore, error)
+	LicenseTemplatesCtx(ctx context.Context) ([]*github.License, error)
+	LicenseTemplateCtx(ctx context.Context, key string) (*github.License, error)
+	RerunFailedJobsCtx(ctx context.Context, repoName string, runID int64) error
+	EstimateCountCtx(ctx context.Context, endpoint string) (int, error)
+	BranchCountCtx(ctx context.Context, repoName string) (int, error)
+	TagCountCtx(ctx context.Context, repoName string) (int, error)
+	ApplySuggestionCtx(ctx context.Context, repoName string, commentID int64) (*github.Commit, error)
+	RateLimitsCtx(ctx context.Context) (*github.RateLimits, error)
+	MergeablePullRequestsCtx(ctx context.Context, repoName string) ([]*github.PullRequest, error)
+	SuspendUserCtx(ctx context.Context, username, reason string) error
+	UnsuspendUserCtx(ctx context.Context, username string) error
+	RulesetsCtx(ctx context.Context, repoName string) ([]*Ruleset, error)
+	RulesetCtx(ctx context.Context, repoName string, id int64) (*Ruleset, error)
+	CreateFileCtx(ctx context.Context, repoName, branch, filePath, message string, content []byte) (*github.RepositoryContentResponse, error)
+	UpdateFileCtx(ctx context.Context, repoName, branch, filePath, message string, content []byte, sha string) (*github.RepositoryContentResponse, error)
+	DeleteFileCtx(ctx context.Context, repoName, branch, filePath, message, sha string) (*github.RepositoryContentResponse, error)
+	SearchInRepoCtx(ctx context.Context, repoName, query string) (*github.CodeSearchResult, error)
+	ReleasesCtx(ctx context.Context, repoName string) []*github.RepositoryRelease
+	ReleaseByTagCtx(ctx context.Context, repoName, tag string) *github.RepositoryRelease
+	CreateReleaseCtx(ctx context.Context, repoName string, r *github.RepositoryRelease) (*github.RepositoryRelease, error)
+	CompareAllCtx(ctx context.Context, repoName, base, head string) (*github.CommitsComparison, error)
+	DiffStatsCtx(ctx context.Context, repoName, base, head string) (files, additions, deletions int, err error)
+	IssuesCtx(ctx context.Context, repoName string, state string) []*github.Issue
+	IssueCtx(ctx context.Context, repoName string, number int) *github.Issue
+	CreateIssueCtx(ctx context.Context, repoName, title, body string, labels []string) (*github.Issue, error)
+	CommitsByAuthorCtx(ctx context.Context, repoName, authorEmail string, since time.Time) ([]*github.RepositoryCommit, error)
+	LabelsCtx(ctx context.Context, repoName string) []*github.Label
+	AddLabelsToIssueCtx(ctx context.Context, repoName string, number int, labels []string) ([]*github.Label, error)
+	RemoveLabelForIssueCtx(ctx context.Context, repoName string, number int, label string) error
+	AuthenticatedCloneURLCtx(ctx context.Context, repoName string) (string, error)
+	CommentOnIssueCtx(ctx context.Context, repoName string, number int, body string) (*github.IssueComment, error)
+	CommentsCtx(ctx context.Context, repoName string, number int) []*github.IssueComment
+	BranchesWithActivityCtx(ctx context.Context, repoName string) ([]BranchActivity, error)
+	EnsurePullRequestCtx(ctx context.Context, repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, bool, error)
+	UpdatePullRequestCtx(ctx context.Context, repoName string, number int, updates *github.PullRequest) (*github.PullRequest, error)
+	ArchiveAndSnapshotCtx(ctx context.Context, repoName string) (archiveURL string, err error)
+	PullRequestsCtx(ctx context.Context, repoName, state string) []*github.PullRequest
+	PullRequestCtx(ctx context.Context, repoName string, number int) *github.PullRequest
+	TeamRepositoriesCtx(ctx context.Context, teamSlug string) ([]*github.Repository, error)
+	AllTeamRepositoriesCtx(ctx context.Context) (map[string][]*github.Repository, error)
+	AssignTeamReviewersCtx(ctx context.Context, id int, repoName string, teams []string) (*github.PullRequest, error)
+	RequestReviewsCtx(ctx context.Context, id int, repoName string, users, teams []string) (*github.PullRequest, error)
+	HookDeliveriesCtx(ctx context.Context, repoName string, hookID int64) ([]*HookDelivery, error)
+	RedeliverHookCtx(ctx context.Context, repoName string, hookID, deliveryID int64) error
+	CreateStatusCtx(ctx context.Context, repoName, sha string, status *github.RepoStatus) (*github.RepoStatus, error)
+	CreateStatusBatchCtx(ctx context.Context, repoName string, shas []string, status *github.RepoStatus, concurrency int) map[string]error
+	ExportTreeCtx(ctx context.Context, repoName, dirPath, ref string) (map[string][]byte, error)
+	CreateCommitCtx(ctx context.Context, repoName, message, treeSHA string, parents []string) (*github.Commit, error)
+	UpdateRefCtx(ctx context.Context, repoName, ref, sha string, force bool) (*github.Reference, error)
+	CreateBranchCtx(ctx context.Context, repoName, newBranch, fromSHA string) (*github.Reference, error)
+	DefaultBranchCtx(ctx context.Context, repoName string) (string, error)
+	SearchRepositoriesCtx(ctx context.Context, query string, opts ...SearchOption) []*github.Repository
+	SearchCodeCtx(ctx context.Context, query string) []*github.CodeResult
+}
+
+// WithContext returns a shallow copy of c issuing its requests with ctx, c keeps its own context. The copy
+// shares the underlying go-github client and transport, which are safe for concurrent use, so a copy per call
+// like client.WithContext(ctx).Repositories("all", "") does not affect other callers
+func (c *Client) WithContext(ctx context.Context) *Client {
+
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// CommitCtx is Commit using ctx for its requests
+func (c *Client) CommitCtx(ctx context.Context, repoName, commitSHA string) *github.Commit {
+
+	return c.WithContext(ctx).Commit(repoName, commitSHA)
+}
+
+// CommitECtx is CommitE using ctx for its requests
+func (c *Client) CommitECtx(ctx context.Context, repoName, commitSHA string) (*github.Commit, error) {
+
+	return c.WithContext(ctx).CommitE(repoName, commitSHA)
+}
+
+// CompareCtx is Compare using ctx for its requests
+func (c *Client) CompareCtx(ctx context.Context, repoName, base, head string) *github.CommitsComparison {
+
+	return c.WithContext(ctx).Compare(repoName, base, head)
+}
+
+// MergeCtx is Merge using ctx for its requests
+func (c *Client) MergeCtx(ctx context.Context, repoName, base, head, message string) *github.RepositoryCommit {
+
+	return c.WithContext(ctx).Merge(repoName, base, head, message)
+}
+
+// RepositoriesCtx is Repositories using ctx for its requests
+func (c *Client) RepositoriesCtx(ctx context.Context, repoType, repoSort string) []*github.Repository {
+
+	return c.WithContext(ctx).Repositories(repoType, repoSort)
+}
+
+// RepositoryCtx is Repository using ctx for its requests
+func (c *Client) RepositoryCtx(ctx context.Context, repoName string) *github.Repository {
+
+	return c.WithContext(ctx).Repository(repoName)
+}
+
+// BranchesCtx is Branches using ctx for its requests
+func (c *Client) BranchesCtx(ctx context.Context, repoName string) []*github.Branch {
+
+	return c.WithContext(ctx).Branches(repoName)
+}
+
+// BranchesECtx is BranchesE using ctx for its requests
+func (c *Client) BranchesECtx(ctx context.Context, repoName string) ([]*github.Branch, error) {
+
+	return c.WithContext(ctx).BranchesE(repoName)
+}
+
+// BranchCtx is Branch using ctx for its requests
+func (c *Client) BranchCtx(ctx context.Context, repoName, branchName string) *github.Branch {
+
+	return c.WithContext(ctx).Branch(repoName, branchName)
+}
+
+// TagsCtx is Tags using ctx for its requests
+func (c *Client) TagsCtx(ctx context.Context, repoName string) []*github.RepositoryTag {
+
+	return c.WithContext(ctx).Tags(repoName)
+}
+
+// TagsECtx is TagsE using ctx for its requests
+func (c *Client) TagsECtx(ctx context.Context, repoName string) ([]*github.RepositoryTag, error) {
+
+	return c.WithContext(ctx).TagsE(repoName)
+}
+
+// TagByNameCtx is TagByName using ctx for its requests
+func (c *Client) TagByNameCtx(ctx context.Context, repoName, tagName string) *github.RepositoryTag {
+
+	return c.WithContext(ctx).TagByName(repoName, tagName)
+}
+
+// ReferenceByBranchCtx is ReferenceByBranch using ctx for its requests
+func (c *Client) ReferenceByBranchCtx(ctx context.Context, repoName, branchName string) *github.Reference {
+
+	return c.WithContext(ctx).ReferenceByBranch(repoName, branchName)
+}
+
+// ReferenceByHeadsCtx is ReferenceByHeads using ctx for its requests
+func (c *Client) ReferenceByHeadsCtx(ctx context.Context, repoName, branchName string) *github.Reference {
+
+	return c.WithContext(ctx).ReferenceByHeads(repoName, branchName)
+}
+
+// ReferenceByTagCtx is ReferenceByTag using ctx for its requests
+func (c *Client) ReferenceByTagCtx(ctx context.Context, repoName, tagName string) *github.Reference {
+
+	return c.WithContext(ctx).ReferenceByTag(repoName, tagName)
+}
+
+// CreateRefsCtx is CreateRefs using ctx for its requests
+func (c *Client) CreateRefsCtx(ctx context.Context, repoName, branchName, SHARef string) *github.Reference {
+
+	return c.WithContext(ctx).CreateRefs(repoName, branchName, SHARef)
+}
+
+// TreeCtx is Tree using ctx for its requests
+func (c *Client) TreeCtx(ctx context.Context, repoName, sourceFiles string, reference *github.Reference) *github.Tree {
+
+	return c.WithContext(ctx).Tree(repoName, sourceFiles, reference)
+}
+
+// TreeFromContentCtx is TreeFromContent using ctx for its requests
+func (c *Client) TreeFromContentCtx(ctx context.Context, repoName string, files map[string][]byte, reference *github.Reference) (*github.Tree, error) {
+
+	return c.WithContext(ctx).TreeFromContent(repoName, files, reference)
+}
+
+// UsersCtx is Users using ctx for its requests
+func (c *Client) UsersCtx(ctx context.Context) []*github.User {
+
+	return c.WithContext(ctx).Users()
+}
+
+// UserCtx is User using ctx for its requests
+func (c *Client) UserCtx(ctx context.Context, userName string) *github.User {
+
+	return c.WithContext(ctx).User(userName)
+}
+
+// CreatePullRequestCtx is CreatePullRequest using ctx for its requests
+func (c *Client) CreatePullRequestCtx(ctx context.Context, repoName, srcBranch, dstBranch, subject, description string) *github.PullRequest {
+
+	return c.WithContext(ctx).CreatePullRequest(repoName, srcBranch, dstBranch, subject, description)
+}
+
+// CreatePullRequestECtx is CreatePullRequestE using ctx for its requests
+func (c *Client) CreatePullRequestECtx(ctx context.Context, repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).CreatePullRequestE(repoName, srcBranch, dstBranch, subject, description)
+}
+
+// AssignReviewersCtx is AssignReviewers using ctx for its requests
+func (c *Client) AssignReviewersCtx(ctx context.Context, id int, repoName string, reviewers []string) *github.PullRequest {
+
+	return c.WithContext(ctx).AssignReviewers(id, repoName, reviewers)
+}
+
+// AssignReviewersECtx is AssignReviewersE using ctx for its requests
+func (c *Client) AssignReviewersECtx(ctx context.Context, id int, repoName string, reviewers []string) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).AssignReviewersE(id, repoName, reviewers)
+}
+
+// RerequestReviewersCtx is RerequestReviewers using ctx for its requests
+func (c *Client) RerequestReviewersCtx(ctx context.Context, id int, repoName string, reviewers []string) *github.PullRequest {
+
+	return c.WithContext(ctx).RerequestReviewers(id, repoName, reviewers)
+}
+
+// DownloadCtx is Download using ctx for its requests
+func (c *Client) DownloadCtx(ctx context.Context, repoName, refName, filePath string) (body io.ReadCloser, err error) {
+
+	return c.WithContext(ctx).Download(repoName, refName, filePath)
+}
+
+// ImportProgressCtx is ImportProgress using ctx for its requests
+func (c *Client) ImportProgressCtx(ctx context.Context, repoName string) (*github.Import, error) {
+
+	return c.WithContext(ctx).ImportProgress(repoName)
+}
+
+// StartImportCtx is StartImport using ctx for its requests
+func (c *Client) StartImportCtx(ctx context.Context, repoName string, req *github.Import) (*github.Import, error) {
+
+	return c.WithContext(ctx).StartImport(repoName, req)
+}
+
+// GPGKeysCtx is GPGKeys using ctx for its requests
+func (c *Client) GPGKeysCtx(ctx context.Context) ([]*github.GPGKey, error) {
+
+	return c.WithContext(ctx).GPGKeys()
+}
+
+// CreateGPGKeyCtx is CreateGPGKey using ctx for its requests
+func (c *Client) CreateGPGKeyCtx(ctx context.Context, armoredKey string) (*github.GPGKey, error) {
+
+	return c.WithContext(ctx).CreateGPGKey(armoredKey)
+}
+
+// DeleteGPGKeyCtx is DeleteGPGKey using ctx for its requests
+func (c *Client) DeleteGPGKeyCtx(ctx context.Context, id int64) error {
+
+	return c.WithContext(ctx).DeleteGPGKey(id)
+}
+
+// MergePullRequestCtx is MergePullRequest using ctx for its requests
+func (c *Client) MergePullRequestCtx(ctx context.Context, repoName string, number int, commitMessage string, method string) (*github.PullRequestMergeResult, error) {
+
+	return c.WithContext(ctx).MergePullRequest(repoName, number, commitMessage, method)
+}
+
+// CheckSuitesCtx is CheckSuites using ctx for its requests
+func (c *Client) CheckSuitesCtx(ctx context.Context, repoName, ref string) ([]*github.CheckSuite, error) {
+
+	return c.WithContext(ctx).CheckSuites(repoName, ref)
+}
+
+// RerequestCheckSuiteCtx is RerequestCheckSuite using ctx for its requests
+func (c *Client) RerequestCheckSuiteCtx(ctx context.Context, repoName string, suiteID int64) error {
+
+	return c.WithContext(ctx).RerequestCheckSuite(repoName, suiteID)
+}
+
+// SubscribeCtx is Subscribe using ctx for its requests
+func (c *Client) SubscribeCtx(ctx context.Context, repoName string, subscribed, ignored bool) (*github.Subscription, error) {
+
+	return c.WithContext(ctx).Subscribe(repoName, subscribed, ignored)
+}
+
+// UnsubscribeCtx is Unsubscribe using ctx for its requests
+func (c *Client) UnsubscribeCtx(ctx context.Context, repoName string) error {
+
+	return c.WithContext(ctx).Unsubscribe(repoName)
+}
+
+// PullRequestForBranchCtx is PullRequestForBranch using ctx for its requests
+func (c *Client) PullRequestForBranchCtx(ctx context.Context, repoName, branch string) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).PullRequestForBranch(repoName, branch)
+}
+
+// ReleaseAssetsCtx is ReleaseAssets using ctx for its requests
+func (c *Client) ReleaseAssetsCtx(ctx context.Context, repoName string, releaseID int64) ([]*github.ReleaseAsset, error) {
+
+	return c.WithContext(ctx).ReleaseAssets(repoName, releaseID)
+}
+
+// DeleteReleaseAssetCtx is DeleteReleaseAsset using ctx for its requests
+func (c *Client) DeleteReleaseAssetCtx(ctx context.Context, repoName string, assetID int64) error {
+
+	return c.WithContext(ctx).DeleteReleaseAsset(repoName, assetID)
+}
+
+// ProtectionDriftCtx is ProtectionDrift using ctx for its requests
+func (c *Client) ProtectionDriftCtx(ctx context.Context, repoName, branch string, desired *github.ProtectionRequest) ([]string, error) {
+
+	return c.WithContext(ctx).ProtectionDrift(repoName, branch, desired)
+}
+
+// CreateRepositoryInitializedCtx is CreateRepositoryInitialized using ctx for its requests
+func (c *Client) CreateRepositoryInitializedCtx(ctx context.Context, repoName string, private bool, gitignoreTemplate, licenseTemplate string) (*github.Repository, error) {
+
+	return c.WithContext(ctx).CreateRepositoryInitialized(repoName, private, gitignoreTemplate, licenseTemplate)
+}
+
+// LatestReleaseCtx is LatestRelease using ctx for its requests
+func (c *Client) LatestReleaseCtx(ctx context.Context, repoName string) (*github.RepositoryRelease, error) {
+
+	return c.WithContext(ctx).LatestRelease(repoName)
+}
+
+// LatestSemverTagCtx is LatestSemverTag using ctx for its requests
+func (c *Client) LatestSemverTagCtx(ctx context.Context, repoName string) (string, error) {
+
+	return c.WithContext(ctx).LatestSemverTag(repoName)
+}
+
+// RepositorySummariesCtx is RepositorySummaries using ctx for its requests
+func (c *Client) RepositorySummariesCtx(ctx context.Context, repoNames []string, concurrency int) (map[string]*RepoSummary, error) {
+
+	return c.WithContext(ctx).RepositorySummaries(repoNames, concurrency)
+}
+
+// WorkflowRunUsageCtx is WorkflowRunUsage using ctx for its requests
+func (c *Client) WorkflowRunUsageCtx(ctx context.Context, repoName string, runID int64) (*github.WorkflowRunUsage, error) {
+
+	return c.WithContext(ctx).WorkflowRunUsage(repoName, runID)
+}
+
+// WorkflowCostCtx is WorkflowCost using ctx for its requests
+func (c *Client) WorkflowCostCtx(ctx context.Context, repoName string, workflowID int64, since time.Time) (map[string]int64, error) {
+
+	return c.WithContext(ctx).WorkflowCost(repoName, workflowID, since)
+}
+
+// PullRequestsAwaitingReviewCtx is PullRequestsAwaitingReview using ctx for its requests
+func (c *Client) PullRequestsAwaitingReviewCtx(ctx context.Context) ([]*github.PullRequest, error) {
+
+	return c.WithContext(ctx).PullRequestsAwaitingReview()
+}
+
+// ClearDefaultLabelsCtx is ClearDefaultLabels using ctx for its requests
+func (c *Client) ClearDefaultLabelsCtx(ctx context.Context, repoName string) error {
+
+	return c.WithContext(ctx).ClearDefaultLabels(repoName)
+}
+
+// DownloadArchiveToCtx is DownloadArchiveTo using ctx for its requests
+func (c *Client) DownloadArchiveToCtx(ctx context.Context, repoName, ref string, format github.ArchiveFormat, w io.Writer, progress func(bytesWritten int64)) error {
+
+	return c.WithContext(ctx).DownloadArchiveTo(repoName, ref, format, w, progress)
+}
+
+// ResolveRefCtx is ResolveRef using ctx for its requests
+func (c *Client) ResolveRefCtx(ctx context.Context, repoName, ref string) (string, error) {
+
+	return c.WithContext(ctx).ResolveRef(repoName, ref)
+}
+
+// CommitByRefCtx is CommitByRef using ctx for its requests
+func (c *Client) CommitByRefCtx(ctx context.Context, repoName, ref string) (*github.RepositoryCommit, error) {
+
+	return c.WithContext(ctx).CommitByRef(repoName, ref)
+}
+
+// GenerateReleaseNotesCtx is GenerateReleaseNotes using ctx for its requests
+func (c *Client) GenerateReleaseNotesCtx(ctx context.Context, repoName, tagName, previousTag string) (*ReleaseNotes, error) {
+
+	return c.WithContext(ctx).GenerateReleaseNotes(repoName, tagName, previousTag)
+}
+
+// MilestonesCtx is Milestones using ctx for its requests
+func (c *Client) MilestonesCtx(ctx context.Context, repoName, state string) []*github.Milestone {
+
+	return c.WithContext(ctx).Milestones(repoName, state)
+}
+
+// MilestoneProgressCtx is MilestoneProgress using ctx for its requests
+func (c *Client) MilestoneProgressCtx(ctx context.Context, repoName string, number int) (percent float64, err error) {
+
+	return c.WithContext(ctx).MilestoneProgress(repoName, number)
+}
+
+// DeleteBranchCtx is DeleteBranch using ctx for its requests
+func (c *Client) DeleteBranchCtx(ctx context.Context, repoName, branch string) error {
+
+	return c.WithContext(ctx).DeleteBranch(repoName, branch)
+}
+
+// DeleteBranchesMatchingCtx is DeleteBranchesMatching using ctx for its requests
+func (c *Client) DeleteBranchesMatchingCtx(ctx context.Context, repoName, pattern string, concurrency int, dryRun bool) ([]string, error) {
+
+	return c.WithContext(ctx).DeleteBranchesMatching(repoName, pattern, concurrency, dryRun)
+}
+
+// SecretScanningAlertsCtx is SecretScanningAlerts using ctx for its requests
+func (c *Client) SecretScanningAlertsCtx(ctx context.Context, repoName string, state string) ([]*SecretScanningAlert, error) {
+
+	return c.WithContext(ctx).SecretScanningAlerts(repoName, state)
+}
+
+// CodeScanningAlertsCtx is CodeScanningAlerts using ctx for its requests
+func (c *Client) CodeScanningAlertsCtx(ctx context.Context, repoName, state string) ([]*github.Alert, error) {
+
+	return c.WithContext(ctx).CodeScanningAlerts(repoName, state)
+}
+
+// SBOMCtx is SBOM using ctx for its requests
+func (c *Client) SBOMCtx(ctx context.Context, repoName string) (*SBOM, error) {
+
+	return c.WithContext(ctx).SBOM(repoName)
+}
+
+// CheckRunAnnotationsCtx is CheckRunAnnotations using ctx for its requests
+func (c *Client) CheckRunAnnotationsCtx(ctx context.Context, repoName string, checkRunID int64) ([]*github.CheckRunAnnotation, error) {
+
+	return c.WithContext(ctx).CheckRunAnnotations(repoName, checkRunID)
+}
+
+// EnsureLabelsCtx is EnsureLabels using ctx for its requests
+func (c *Client) EnsureLabelsCtx(ctx context.Context, repoName string, labels []*github.Label) error {
+
+	return c.WithContext(ctx).EnsureLabels(repoName, labels)
+}
+
+// FilesFromTreeCtx is FilesFromTree using ctx for its requests
+func (c *Client) FilesFromTreeCtx(ctx context.Context, repoName, ref string, paths []string) (map[string][]byte, error) {
+
+	return c.WithContext(ctx).FilesFromTree(repoName, ref, paths)
+}
+
+// CreateIssueFromRequestCtx is CreateIssueFromRequest using ctx for its requests
+func (c *Client) CreateIssueFromRequestCtx(ctx context.Context, repoName string, req *github.IssueRequest) (*github.Issue, error) {
+
+	return c.WithContext(ctx).CreateIssueFromRequest(repoName, req)
+}
+
+// MergeQueueEntriesCtx is MergeQueueEntries using ctx for its requests
+func (c *Client) MergeQueueEntriesCtx(ctx context.Context, repoName, branch string) ([]MergeQueueEntry, error) {
+
+	return c.WithContext(ctx).MergeQueueEntries(repoName, branch)
+}
+
+// ClosePullRequestCtx is ClosePullRequest using ctx for its requests
+func (c *Client) ClosePullRequestCtx(ctx context.Context, repoName string, number int) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).ClosePullRequest(repoName, number)
+}
+
+// ClosePullRequestWithReasonCtx is ClosePullRequestWithReason using ctx for its requests
+func (c *Client) ClosePullRequestWithReasonCtx(ctx context.Context, repoName string, number int, reason string) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).ClosePullRequestWithReason(repoName, number, reason)
+}
+
+// ReopenPullRequestCtx is ReopenPullRequest using ctx for its requests
+func (c *Client) ReopenPullRequestCtx(ctx context.Context, repoName string, number int) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).ReopenPullRequest(repoName, number)
+}
+
+// ReopenIssueCtx is ReopenIssue using ctx for its requests
+func (c *Client) ReopenIssueCtx(ctx context.Context, repoName string, number int) (*github.Issue, error) {
+
+	return c.WithContext(ctx).ReopenIssue(repoName, number)
+}
+
+// AssignToLeastLoadedCtx is AssignToLeastLoaded using ctx for its requests
+func (c *Client) AssignToLeastLoadedCtx(ctx context.Context, repoName string, number int, candidates []string) (*github.Issue, error) {
+
+	return c.WithContext(ctx).AssignToLeastLoaded(repoName, number, candidates)
+}
+
+// ForkParentCtx is ForkParent using ctx for its requests
+func (c *Client) ForkParentCtx(ctx context.Context, repoName string) (*github.Repository, error) {
+
+	return c.WithContext(ctx).ForkParent(repoName)
+}
+
+// ForkSourceCtx is ForkSource using ctx for its requests
+func (c *Client) ForkSourceCtx(ctx context.Context, repoName string) (*github.Repository, error) {
+
+	return c.WithContext(ctx).ForkSource(repoName)
+}
+
+// PendingDeploymentsCtx is PendingDeployments using ctx for its requests
+func (c *Client) PendingDeploymentsCtx(ctx context.Context, repoName string, runID int64) ([]*PendingDeployment, error) {
+
+	return c.WithContext(ctx).PendingDeployments(repoName, runID)
+}
+
+// ApproveDeploymentCtx is ApproveDeployment using ctx for its requests
+func (c *Client) ApproveDeploymentCtx(ctx context.Context, repoName string, runID int64, envIDs []int64, comment string) error {
+
+	return c.WithContext(ctx).ApproveDeployment(repoName, runID, envIDs, comment)
+}
+
+// BranchSHACtx is BranchSHA using ctx for its requests
+func (c *Client) BranchSHACtx(ctx context.Context, repoName, branch string) (string, error) {
+
+	return c.WithContext(ctx).BranchSHA(repoName, branch)
+}
+
+// RepositoriesPushedSinceCtx is RepositoriesPushedSince using ctx for its requests
+func (c *Client) RepositoriesPushedSinceCtx(ctx context.Context, since time.Time) ([]*github.Repository, error) {
+
+	return c.WithContext(ctx).RepositoriesPushedSince(since)
+}
+
+// DependabotSecretsCtx is DependabotSecrets using ctx for its requests
+func (c *Client) DependabotSecretsCtx(ctx context.Context, repoName string) ([]*github.Secret, error) {
+
+	return c.WithContext(ctx).DependabotSecrets(repoName)
+}
+
+// CreateOrUpdateDependabotSecretCtx is CreateOrUpdateDependabotSecret using ctx for its requests
+func (c *Client) CreateOrUpdateDependabotSecretCtx(ctx context.Context, repoName, name string, plaintext []byte) error {
+
+	return c.WithContext(ctx).CreateOrUpdateDependabotSecret(repoName, name, plaintext)
+}
+
+// DeleteDependabotSecretCtx is DeleteDependabotSecret using ctx for its requests
+func (c *Client) DeleteDependabotSecretCtx(ctx context.Context, repoName, name string) error {
+
+	return c.WithContext(ctx).DeleteDependabotSecret(repoName, name)
+}
+
+// DownloadResumableCtx is DownloadResumable using ctx for its requests
+func (c *Client) DownloadResumableCtx(ctx context.Context, repoName, ref, filePath string, w io.WriteSeeker) error {
+
+	return c.WithContext(ctx).DownloadResumable(repoName, ref, filePath, w)
+}
+
+// StatusesByCreatorCtx is StatusesByCreator using ctx for its requests
+func (c *Client) StatusesByCreatorCtx(ctx context.Context, repoName, ref string) (map[string][]*github.RepoStatus, error) {
+
+	return c.WithContext(ctx).StatusesByCreator(repoName, ref)
+}
+
+// RevertCtx is Revert using ctx for its requests
+func (c *Client) RevertCtx(ctx context.Context, repoName, commitSHA, branch string) (*github.RepositoryCommit, error) {
+
+	return c.WithContext(ctx).Revert(repoName, commitSHA, branch)
+}
+
+// FileMetaCtx is FileMeta using ctx for its requests
+func (c *Client) FileMetaCtx(ctx context.Context, repoName, filePath, ref string) (*FileInfo, error) {
+
+	return c.WithContext(ctx).FileMeta(repoName, filePath, ref)
+}
+
+// FileIsBinaryCtx is FileIsBinary using ctx for its requests
+func (c *Client) FileIsBinaryCtx(ctx context.Context, repoName, filePath, ref string) (bool, error) {
+
+	return c.WithContext(ctx).FileIsBinary(repoName, filePath, ref)
+}
+
+// StartMigrationCtx is StartMigration using ctx for its requests
+func (c *Client) StartMigrationCtx(ctx context.Context, repoNames []string, lockRepositories bool) (*github.Migration, error) {
+
+	return c.WithContext(ctx).StartMigration(repoNames, lockRepositories)
+}
+
+// MigrationStatusCtx is MigrationStatus using ctx for its requests
+func (c *Client) MigrationStatusCtx(ctx context.Context, id int64) (*github.Migration, error) {
+
+	return c.WithContext(ctx).MigrationStatus(id)
+}
+
+// DownloadMigrationArchiveCtx is DownloadMigrationArchive using ctx for its requests
+func (c *Client) DownloadMigrationArchiveCtx(ctx context.Context, id int64) (io.ReadCloser, error) {
+
+	return c.WithContext(ctx).DownloadMigrationArchive(id)
+}
+
+// SetFeaturesCtx is SetFeatures using ctx for its requests
+func (c *Client) SetFeaturesCtx(ctx context.Context, repoName string, hasIssues, hasWiki, hasProjects, hasDiscussions *bool) (*github.Repository, error) {
+
+	return c.WithContext(ctx).SetFeatures(repoName, hasIssues, hasWiki, hasProjects, hasDiscussions)
+}
+
+// WaitForRefCtx is WaitForRef using ctx for its requests
+func (c *Client) WaitForRefCtx(ctx context.Context, repoName, ref string, timeout time.Duration) (*github.Reference, error) {
+
+	return c.WithContext(ctx).WaitForRef(repoName, ref, timeout)
+}
+
+// ReviewRequestAgesCtx is ReviewRequestAges using ctx for its requests
+func (c *Client) ReviewRequestAgesCtx(ctx context.Context, repoName string, number int) (map[string]time.Duration, error) {
+
+	return c.WithContext(ctx).ReviewRequestAges(repoName, number)
+}
+
+// LabelInventoryCtx is LabelInventory using ctx for its requests
+func (c *Client) LabelInventoryCtx(ctx context.Context) (map[string]int, error) {
+
+	return c.WithContext(ctx).LabelInventory()
+}
+
+// GitignoreTemplatesCtx is GitignoreTemplates using ctx for its requests
+func (c *Client) GitignoreTemplatesCtx(ctx context.Context) ([]string, error) {
+
+	return c.WithContext(ctx).GitignoreTemplates()
+}
+
+// GitignoreTemplateCtx is GitignoreTemplate using ctx for its requests
+func (c *Client) GitignoreTemplateCtx(ctx context.Context, name string) (*github.Gitignore, error) {
+
+	return c.WithContext(ctx).GitignoreTemplate(name)
+}
+
+// LicenseTemplatesCtx is LicenseTemplates using ctx for its requests
+func (c *Client) LicenseTemplatesCtx(ctx context.Context) ([]*github.License, error) {
+
+	return c.WithContext(ctx).LicenseTemplates()
+}
+
+// LicenseTemplateCtx is LicenseTemplate using ctx for its requests
+func (c *Client) LicenseTemplateCtx(ctx context.Context, key string) (*github.License, error) {
+
+	return c.WithContext(ctx).LicenseTemplate(key)
+}
+
+// RerunFailedJobsCtx is RerunFailedJobs using ctx for its requests
+func (c *Client) RerunFailedJobsCtx(ctx context.Context, repoName string, runID int64) error {
+
+	return c.WithContext(ctx).RerunFailedJobs(repoName, runID)
+}
+
+// EstimateCountCtx is EstimateCount using ctx for its requests
+func (c *Client) EstimateCountCtx(ctx context.Context, endpoint string) (int, error) {
+
+	return c.WithContext(ctx).EstimateCount(endpoint)
+}
+
+// BranchCountCtx is BranchCount using ctx for its requests
+func (c *Client) BranchCountCtx(ctx context.Context, repoName string) (int, error) {
+
+	return c.WithContext(ctx).BranchCount(repoName)
+}
+
+// TagCountCtx is TagCount using ctx for its requests
+func (c *Client) TagCountCtx(ctx context.Context, repoName string) (int, error) {
+
+	return c.WithContext(ctx).TagCount(repoName)
+}
+
+// ApplySuggestionCtx is ApplySuggestion using ctx for its requests
+func (c *Client) ApplySuggestionCtx(ctx context.Context, repoName string, commentID int64) (*github.Commit, error) {
+
+	return c.WithContext(ctx).ApplySuggestion(repoName, commentID)
+}
+
+// RateLimitsCtx is RateLimits using ctx for its requests
+func (c *Client) RateLimitsCtx(ctx context.Context) (*github.RateLimits, error) {
+
+	return c.WithContext(ctx).RateLimits()
+}
+
+// MergeablePullRequestsCtx is MergeablePullRequests using ctx for its requests
+func (c *Client) MergeablePullRequestsCtx(ctx context.Context, repoName string) ([]*github.PullRequest, error) {
+
+	return c.WithContext(ctx).MergeablePullRequests(repoName)
+}
+
+// SuspendUserCtx is SuspendUser using ctx for its requests
+func (c *Client) SuspendUserCtx(ctx context.Context, username, reason string) error {
+
+	return c.WithContext(ctx).SuspendUser(username, reason)
+}
+
+// UnsuspendUserCtx is UnsuspendUser using ctx for its requests
+func (c *Client) UnsuspendUserCtx(ctx context.Context, username string) error {
+
+	return c.WithContext(ctx).UnsuspendUser(username)
+}
+
+// RulesetsCtx is Rulesets using ctx for its requests
+func (c *Client) RulesetsCtx(ctx context.Context, repoName string) ([]*Ruleset, error) {
+
+	return c.WithContext(ctx).Rulesets(repoName)
+}
+
+// RulesetCtx is Ruleset using ctx for its requests
+func (c *Client) RulesetCtx(ctx context.Context, repoName string, id int64) (*Ruleset, error) {
+
+	return c.WithContext(ctx).Ruleset(repoName, id)
+}
+
+// CreateFileCtx is CreateFile using ctx for its requests
+func (c *Client) CreateFileCtx(ctx context.Context, repoName, branch, filePath, message string, content []byte) (*github.RepositoryContentResponse, error) {
+
+	return c.WithContext(ctx).CreateFile(repoName, branch, filePath, message, content)
+}
+
+// UpdateFileCtx is UpdateFile using ctx for its requests
+func (c *Client) UpdateFileCtx(ctx context.Context, repoName, branch, filePath, message string, content []byte, sha string) (*github.RepositoryContentResponse, error) {
+
+	return c.WithContext(ctx).UpdateFile(repoName, branch, filePath, message, content, sha)
+}
+
+// DeleteFileCtx is DeleteFile using ctx for its requests
+func (c *Client) DeleteFileCtx(ctx context.Context, repoName, branch, filePath, message, sha string) (*github.RepositoryContentResponse, error) {
+
+	return c.WithContext(ctx).DeleteFile(repoName, branch, filePath, message, sha)
+}
+
+// SearchInRepoCtx is SearchInRepo using ctx for its requests
+func (c *Client) SearchInRepoCtx(ctx context.Context, repoName, query string) (*github.CodeSearchResult, error) {
+
+	return c.WithContext(ctx).SearchInRepo(repoName, query)
+}
+
+// ReleasesCtx is Releases using ctx for its requests
+func (c *Client) ReleasesCtx(ctx context.Context, repoName string) []*github.RepositoryRelease {
+
+	return c.WithContext(ctx).Releases(repoName)
+}
+
+// ReleaseByTagCtx is ReleaseByTag using ctx for its requests
+func (c *Client) ReleaseByTagCtx(ctx context.Context, repoName, tag string) *github.RepositoryRelease {
+
+	return c.WithContext(ctx).ReleaseByTag(repoName, tag)
+}
+
+// CreateReleaseCtx is CreateRelease using ctx for its requests
+func (c *Client) CreateReleaseCtx(ctx context.Context, repoName string, r *github.RepositoryRelease) (*github.RepositoryRelease, error) {
+
+	return c.WithContext(ctx).CreateRelease(repoName, r)
+}
+
+// CompareAllCtx is CompareAll using ctx for its requests
+func (c *Client) CompareAllCtx(ctx context.Context, repoName, base, head string) (*github.CommitsComparison, error) {
+
+	return c.WithContext(ctx).CompareAll(repoName, base, head)
+}
+
+// DiffStatsCtx is DiffStats using ctx for its requests
+func (c *Client) DiffStatsCtx(ctx context.Context, repoName, base, head string) (files, additions, deletions int, err error) {
+
+	return c.WithContext(ctx).DiffStats(repoName, base, head)
+}
+
+// IssuesCtx is Issues using ctx for its requests
+func (c *Client) IssuesCtx(ctx context.Context, repoName string, state string) []*github.Issue {
+
+	return c.WithContext(ctx).Issues(repoName, state)
+}
+
+// IssueCtx is Issue using ctx for its requests
+func (c *Client) IssueCtx(ctx context.Context, repoName string, number int) *github.Issue {
+
+	return c.WithContext(ctx).Issue(repoName, number)
+}
+
+// CreateIssueCtx is CreateIssue using ctx for its requests
+func (c *Client) CreateIssueCtx(ctx context.Context, repoName, title, body string, labels []string) (*github.Issue, error) {
+
+	return c.WithContext(ctx).CreateIssue(repoName, title, body, labels)
+}
+
+// CommitsByAuthorCtx is CommitsByAuthor using ctx for its requests
+func (c *Client) CommitsByAuthorCtx(ctx context.Context, repoName, authorEmail string, since time.Time) ([]*github.RepositoryCommit, error) {
+
+	return c.WithContext(ctx).CommitsByAuthor(repoName, authorEmail, since)
+}
+
+// LabelsCtx is Labels using ctx for its requests
+func (c *Client) LabelsCtx(ctx context.Context, repoName string) []*github.Label {
+
+	return c.WithContext(ctx).Labels(repoName)
+}
+
+// AddLabelsToIssueCtx is AddLabelsToIssue using ctx for its requests
+func (c *Client) AddLabelsToIssueCtx(ctx context.Context, repoName string, number int, labels []string) ([]*github.Label, error) {
+
+	return c.WithContext(ctx).AddLabelsToIssue(repoName, number, labels)
+}
+
+// RemoveLabelForIssueCtx is RemoveLabelForIssue using ctx for its requests
+func (c *Client) RemoveLabelForIssueCtx(ctx context.Context, repoName string, number int, label string) error {
+
+	return c.WithContext(ctx).RemoveLabelForIssue(repoName, number, label)
+}
+
+// AuthenticatedCloneURLCtx is AuthenticatedCloneURL using ctx for its requests
+func (c *Client) AuthenticatedCloneURLCtx(ctx context.Context, repoName string) (string, error) {
+
+	return c.WithContext(ctx).AuthenticatedCloneURL(repoName)
+}
+
+// CommentOnIssueCtx is CommentOnIssue using ctx for its requests
+func (c *Client) CommentOnIssueCtx(ctx context.Context, repoName string, number int, body string) (*github.IssueComment, error) {
+
+	return c.WithContext(ctx).CommentOnIssue(repoName, number, body)
+}
+
+// CommentsCtx is Comments using ctx for its requests
+func (c *Client) CommentsCtx(ctx context.Context, repoName string, number int) []*github.IssueComment {
+
+	return c.WithContext(ctx).Comments(repoName, number)
+}
+
+// BranchesWithActivityCtx is BranchesWithActivity using ctx for its requests
+func (c *Client) BranchesWithActivityCtx(ctx context.Context, repoName string) ([]BranchActivity, error) {
+
+	return c.WithContext(ctx).BranchesWithActivity(repoName)
+}
+
+// EnsurePullRequestCtx is EnsurePullRequest using ctx for its requests
+func (c *Client) EnsurePullRequestCtx(ctx context.Context, repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, bool, error) {
+
+	return c.WithContext(ctx).EnsurePullRequest(repoName, srcBranch, dstBranch, subject, description)
+}
+
+// UpdatePullRequestCtx is UpdatePullRequest using ctx for its requests
+func (c *Client) UpdatePullRequestCtx(ctx context.Context, repoName string, number int, updates *github.PullRequest) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).UpdatePullRequest(repoName, number, updates)
+}
+
+// ArchiveAndSnapshotCtx is ArchiveAndSnapshot using ctx for its requests
+func (c *Client) ArchiveAndSnapshotCtx(ctx context.Context, repoName string) (archiveURL string, err error) {
+
+	return c.WithContext(ctx).ArchiveAndSnapshot(repoName)
+}
+
+// PullRequestsCtx is PullRequests using ctx for its requests
+func (c *Client) PullRequestsCtx(ctx context.Context, repoName, state string) []*github.PullRequest {
+
+	return c.WithContext(ctx).PullRequests(repoName, state)
+}
+
+// PullRequestCtx is PullRequest using ctx for its requests
+func (c *Client) PullRequestCtx(ctx context.Context, repoName string, number int) *github.PullRequest {
+
+	return c.WithContext(ctx).PullRequest(repoName, number)
+}
+
+// TeamRepositoriesCtx is TeamRepositories using ctx for its requests
+func (c *Client) TeamRepositoriesCtx(ctx context.Context, teamSlug string) ([]*github.Repository, error) {
+
+	return c.WithContext(ctx).TeamRepositories(teamSlug)
+}
+
+// AllTeamRepositoriesCtx is AllTeamRepositories using ctx for its requests
+func (c *Client) AllTeamRepositoriesCtx(ctx context.Context) (map[string][]*github.Repository, error) {
+
+	return c.WithContext(ctx).AllTeamRepositories()
+}
+
+// AssignTeamReviewersCtx is AssignTeamReviewers using ctx for its requests
+func (c *Client) AssignTeamReviewersCtx(ctx context.Context, id int, repoName string, teams []string) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).AssignTeamReviewers(id, repoName, teams)
+}
+
+// RequestReviewsCtx is RequestReviews using ctx for its requests
+func (c *Client) RequestReviewsCtx(ctx context.Context, id int, repoName string, users, teams []string) (*github.PullRequest, error) {
+
+	return c.WithContext(ctx).RequestReviews(id, repoName, users, teams)
+}
+
+// HookDeliveriesCtx is HookDeliveries using ctx for its requests
+func (c *Client) HookDeliveriesCtx(ctx context.Context, repoName string, hookID int64) ([]*HookDelivery, error) {
+
+	return c.WithContext(ctx).HookDeliveries(repoName, hookID)
+}
+
+// RedeliverHookCtx is RedeliverHook using ctx for its requests
+func (c *Client) RedeliverHookCtx(ctx context.Context, repoName string, hookID, deliveryID int64) error {
+
+	return c.WithContext(ctx).RedeliverHook(repoName, hookID, deliveryID)
+}
+
+// CreateStatusCtx is CreateStatus using ctx for its requests
+func (c *Client) CreateStatusCtx(ctx context.Context, repoName, sha string, status *github.RepoStatus) (*github.RepoStatus, error) {
+
+	return c.WithContext(ctx).CreateStatus(repoName, sha, status)
+}
+
+// CreateStatusBatchCtx is CreateStatusBatch using ctx for its requests
+func (c *Client) CreateStatusBatchCtx(ctx context.Context, repoName string, shas []string, status *github.RepoStatus, concurrency int) map[string]error {
+
+	return c.WithContext(ctx).CreateStatusBatch(repoName, shas, status, concurrency)
+}
+
+// ExportTreeCtx is ExportTree using ctx for its requests
+func (c *Client) ExportTreeCtx(ctx context.Context, repoName, dirPath, ref string) (map[string][]byte, error) {
+
+	return c.WithContext(ctx).ExportTree(repoName, dirPath, ref)
+}
+
+// CreateCommitCtx is CreateCommit using ctx for its requests
+func (c *Client) CreateCommitCtx(ctx context.Context, repoName, message, treeSHA string, parents []string) (*github.Commit, error) {
+
+	return c.WithContext(ctx).CreateCommit(repoName, message, treeSHA, parents)
+}
+
+// UpdateRefCtx is UpdateRef using ctx for its requests
+func (c *Client) UpdateRefCtx(ctx context.Context, repoName, ref, sha string, force bool) (*github.Reference, error) {
+
+	return c.WithContext(ctx).UpdateRef(repoName, ref, sha, force)
+}
+
+// CreateBranchCtx is CreateBranch using ctx for its requests
+func (c *Client) CreateBranchCtx(ctx context.Context, repoName, newBranch, fromSHA string) (*github.Reference, error) {
+
+	return c.WithContext(ctx).CreateBranch(repoName, newBranch, fromSHA)
+}
+
+// DefaultBranchCtx is DefaultBranch using ctx for its requests
+func (c *Client) DefaultBranchCtx(ctx context.Context, repoName string) (string, error) {
+
+	return c.WithContext(ctx).DefaultBranch(repoName)
+}
+
+// SearchRepositoriesCtx is SearchRepositories using ctx for its requests
+func (c *Client) SearchRepositoriesCtx(ctx context.Context, query string, opts ...SearchOption) []*github.Repository {
+
+	return c.WithContext(ctx).SearchRepositories(query, opts...)
+}
+
+// SearchCodeCtx is SearchCode using ctx for its requests
+func (c *Client) SearchCodeCtx(ctx context.Context, query string) []*github.CodeResult {
+
+	return c.WithContext(ctx).SearchCode(query)
+}
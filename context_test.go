@@ -0,0 +1,43 @@
+package git
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestOperationsContext(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/branches", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"master"}]`))
+	})
+
+	var ops OperationsContext = client
+	branches, err := ops.BranchesECtx(context.Background(), "repo")
+	assert.NoError(t, err)
+	assert.Len(t, branches, 1)
+
+	// a cancelled context fails the call while the client keeps its own
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ops.BranchesECtx(ctx, "repo")
+	assert.Error(t, err)
+
+	branches, err = client.BranchesE("repo")
+	assert.NoError(t, err)
+	assert.Len(t, branches, 1)
+}
+
+func TestWithContextLeavesClientUntouched(t *testing.T) {
+	client := New("")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scoped := client.WithContext(ctx)
+	assert.Equal(t, ctx, scoped.ctx)
+	assert.Equal(t, context.Background(), client.ctx)
+	assert.Same(t, client.github, scoped.github)
+}
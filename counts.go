@@ -0,0 +1,52 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// EstimateCount returns how many items the listing endpoint, like "repos/org/repo/branches", holds by asking
+// for one item per page and reading the last page number of the Link header, without fetching every page
+func (c *Client) EstimateCount(endpoint string) (int, error) {
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return 0, err
+	}
+	query := u.Query()
+	query.Set("per_page", "1")
+	query.Del("page")
+	u.RawQuery = query.Encode()
+
+	var items []json.RawMessage
+	response, err := c.do("GET", u.String(), nil, &items)
+	if err != nil {
+		return 0, notFound(err)
+	}
+
+	// a single page carries no Link header, its item, if any, is the whole listing
+	if response.LastPage == 0 {
+		if response.NextPage != 0 {
+			return 0, fmt.Errorf("%s does not report its last page", endpoint)
+		}
+		return len(items), nil
+	}
+	return response.LastPage, nil
+}
+
+// BranchCount returns how many branches repoName has, none for an empty repository
+func (c *Client) BranchCount(repoName string) (int, error) {
+
+	count, err := c.EstimateCount(fmt.Sprintf("repos/%s/%s/branches", c.Organization, repoName))
+	if emptyRepository(err) == ErrEmptyRepository {
+		return 0, nil
+	}
+	return count, err
+}
+
+// TagCount returns how many tags repoName has
+func (c *Client) TagCount(repoName string) (int, error) {
+
+	return c.EstimateCount(fmt.Sprintf("repos/%s/%s/tags", c.Organization, repoName))
+}
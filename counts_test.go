@@ -0,0 +1,37 @@
+package git
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestEstimateCount(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/branches", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		w.Header().Set("Link", fmt.Sprintf(`<%s?per_page=1&page=2>; rel="next", <%s?per_page=1&page=37>; rel="last"`, r.URL.Path, r.URL.Path))
+		_, _ = w.Write([]byte(`[{"name":"master"}]`))
+	})
+	mux.HandleFunc("/repos/org/repo/tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"v1.0.0"}]`))
+	})
+	mux.HandleFunc("/repos/org/empty/tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	branches, err := client.BranchCount("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, 37, branches)
+
+	tags, err := client.TagCount("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, tags)
+
+	tags, err = client.TagCount("empty")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, tags)
+}
@@ -0,0 +1,60 @@
+package git
+
+import (
+	"fmt"
+)
+
+// SBOM is the SPDX software bill of materials exported by the dependency graph of a repository
+type SBOM struct {
+	SPDXID            string            `json:"SPDXID"`
+	SPDXVersion       string            `json:"spdxVersion"`
+	Name              string            `json:"name"`
+	DataLicense       string            `json:"dataLicense"`
+	DocumentNamespace string            `json:"documentNamespace"`
+	CreationInfo      *SBOMCreationInfo `json:"creationInfo,omitempty"`
+	DocumentDescribes []string          `json:"documentDescribes,omitempty"`
+	Packages          []*SBOMPackage    `json:"packages,omitempty"`
+}
+
+// SBOMCreationInfo tells when and by which tools an SBOM was created
+type SBOMCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+// SBOMPackage is a component listed in an SBOM
+type SBOMPackage struct {
+	SPDXID           string             `json:"SPDXID"`
+	Name             string             `json:"name"`
+	VersionInfo      string             `json:"versionInfo,omitempty"`
+	DownloadLocation string             `json:"downloadLocation,omitempty"`
+	FilesAnalyzed    bool               `json:"filesAnalyzed"`
+	LicenseConcluded string             `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  string             `json:"licenseDeclared,omitempty"`
+	CopyrightText    string             `json:"copyrightText,omitempty"`
+	ExternalRefs     []*SBOMExternalRef `json:"externalRefs,omitempty"`
+}
+
+// SBOMExternalRef identifies a package outside the SBOM, usually by its package URL
+type SBOMExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+// SBOM exports the SPDX document of the dependency graph of repoName, ErrNotFound when the graph is disabled
+func (c *Client) SBOM(repoName string) (*SBOM, error) {
+
+	var export struct {
+		SBOM *SBOM `json:"sbom"`
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/dependency-graph/sbom", c.Organization, repoName)
+	if _, err := c.do("GET", u, nil, &export); err != nil {
+		return nil, notFound(err)
+	}
+	if export.SBOM == nil {
+		return nil, ErrNotFound
+	}
+	return export.SBOM, nil
+}
@@ -0,0 +1,149 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"io"
+	"net/http"
+	"time"
+)
+
+// resumeAttempts and resumeBackoff bound how DownloadResumable retries after a dropped connection
+const (
+	resumeAttempts = 5
+	resumeBackoff  = time.Second
+)
+
+// DownloadArchiveTo streams the format archive of repoName at ref into w, progress, when not nil, is called
+// with the bytes written so far after every chunk. Cancelling the client context aborts the download
+func (c *Client) DownloadArchiveTo(repoName, ref string, format github.ArchiveFormat, w io.Writer, progress func(bytesWritten int64)) error {
+
+	if len(repoName) == 0 {
+		return fmt.Errorf("repo cannot be null nor empty")
+	}
+
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
+	link, _, err := c.github.Repositories.GetArchiveLink(c.ctx, c.Organization, repoName, format, opts, false)
+	if err != nil {
+		return notFound(err)
+	}
+
+	request, err := http.NewRequestWithContext(c.ctx, http.MethodGet, link.String(), nil)
+	if err != nil {
+		return err
+	}
+	response, err := c.tClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("archive download of %s failed: %s", repoName, response.Status)
+	}
+
+	_, err = io.Copy(&progressWriter{writer: w, progress: progress}, response.Body)
+	return err
+}
+
+// progressWriter counts the bytes going through writer and reports the running total to progress
+type progressWriter struct {
+	writer   io.Writer
+	written  int64
+	progress func(bytesWritten int64)
+}
+
+// Write implements io.Writer
+func (p *progressWriter) Write(b []byte) (int, error) {
+
+	n, err := p.writer.Write(b)
+	p.written += int64(n)
+	if p.progress != nil {
+		p.progress(p.written)
+	}
+	return n, err
+}
+
+// DownloadResumable writes filePath of repoName at ref into w starting at the current offset of w, so a partial
+// download can be continued. A dropped connection is retried with an HTTP Range request from the last byte
+// written, a server ignoring the range restarts the file from its beginning
+func (c *Client) DownloadResumable(repoName, ref, filePath string, w io.WriteSeeker) error {
+
+	if len(repoName) == 0 {
+		return fmt.Errorf("repo cannot be null nor empty")
+	}
+	if len(filePath) == 0 {
+		return fmt.Errorf("filePath cannot be null nor empty")
+	}
+
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
+	file, _, _, err := c.github.Repositories.GetContents(c.ctx, c.Organization, repoName, filePath, opts)
+	if err != nil {
+		return notFound(err)
+	}
+	if file == nil || file.DownloadURL == nil {
+		return fmt.Errorf("%s is not a file", filePath)
+	}
+
+	offset, err := w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+
+	delay := resumeBackoff
+	for attempt := 1; ; attempt++ {
+		var done bool
+		if done, offset, err = c.downloadRange(file.GetDownloadURL(), w, offset); done || err == nil {
+			return err
+		}
+		if attempt == resumeAttempts || c.ctx.Err() != nil {
+			return fmt.Errorf("download of %s interrupted at byte %d: %w", filePath, offset, err)
+		}
+		if err = c.sleep(delay); err != nil {
+			return err
+		}
+		delay *= 2
+	}
+}
+
+// downloadRange copies downloadURL from offset into w, returning the new offset. done reports a definitive
+// outcome, on success or on an error not worth retrying, while a transient failure leaves it false
+func (c *Client) downloadRange(downloadURL string, w io.WriteSeeker, offset int64) (done bool, written int64, err error) {
+
+	request, err := http.NewRequestWithContext(c.ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return true, offset, err
+	}
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	response, err := c.tClient.Do(request)
+	if err != nil {
+		return false, offset, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// the whole file is sent again, write it from the beginning
+		if offset, err = w.Seek(0, io.SeekStart); err != nil {
+			return true, offset, err
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// nothing left past offset, the file is complete
+		return true, offset, nil
+	default:
+		if response.StatusCode >= http.StatusInternalServerError {
+			return false, offset, fmt.Errorf("download failed: %s", response.Status)
+		}
+		return true, offset, fmt.Errorf("download failed: %s", response.Status)
+	}
+
+	if _, err = w.Seek(offset, io.SeekStart); err != nil {
+		return true, offset, err
+	}
+	n, err := io.Copy(w, response.Body)
+	return err == nil, offset + n, err
+}
@@ -0,0 +1,105 @@
+package git
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// memoryFile is an in memory io.WriteSeeker
+type memoryFile struct {
+	data   []byte
+	offset int64
+}
+
+func (m *memoryFile) Write(b []byte) (int, error) {
+	if end := m.offset + int64(len(b)); end > int64(len(m.data)) {
+		m.data = append(m.data, make([]byte, end-int64(len(m.data)))...)
+	}
+	copy(m.data[m.offset:], b)
+	m.offset += int64(len(b))
+	return len(b), nil
+}
+
+func (m *memoryFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		m.offset = offset
+	case io.SeekCurrent:
+		m.offset += offset
+	case io.SeekEnd:
+		m.offset = int64(len(m.data)) + offset
+	}
+	return m.offset, nil
+}
+
+func TestDownloadResumable(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	const content = "0123456789"
+	var serverURL string
+	mux.HandleFunc("/repos/org/repo/contents/dist/app.bin", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"type":"file","name":"app.bin","path":"dist/app.bin","download_url":"%s/raw/app.bin"}`, serverURL)
+	})
+
+	var ranges []string
+	mux.HandleFunc("/raw/app.bin", func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if len(ranges) == 1 {
+			// drop the connection half way through the body
+			conn, buffer, _ := w.(http.Hijacker).Hijack()
+			fmt.Fprintf(buffer, "HTTP/1.1 200 OK\r\nContent-Length: %d\r\n\r\n%s", len(content), content[:5])
+			_ = buffer.Flush()
+			_ = conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, content[5:])
+	})
+	serverURL = client.github.BaseURL.String()
+	serverURL = serverURL[:len(serverURL)-1]
+
+	file := &memoryFile{}
+	assert.NoError(t, client.DownloadResumable("repo", "main", "dist/app.bin", file))
+	assert.Equal(t, content, string(file.data))
+	assert.Equal(t, []string{"", "bytes=5-"}, ranges)
+}
+
+func TestDownloadAuthenticated(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := New("secret")
+	client.Organization = "org"
+	client.github.BaseURL, _ = url.Parse(server.URL + "/")
+
+	mux.HandleFunc("/repos/org/repo/contents/config", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `[{"type":"file","name":"app.yaml","download_url":"%[1]s/raw/app.yaml"},
+			{"type":"file","name":"gone.yaml","download_url":"%[1]s/raw/gone.yaml"}]`, server.URL)
+	})
+	mux.HandleFunc("/raw/app.yaml", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("key: value\n"))
+	})
+
+	body, err := client.Download("repo", "master", "config/app.yaml")
+	if assert.NoError(t, err) {
+		content, _ := ioutil.ReadAll(body)
+		body.Close()
+		assert.Equal(t, "key: value\n", string(content))
+	}
+
+	body, err = client.Download("repo", "master", "config/gone.yaml")
+	assert.Nil(t, body)
+	assert.Error(t, err)
+}
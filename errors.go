@@ -0,0 +1,73 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"time"
+)
+
+// ErrNotFound is returned when GitHub answers 404 for the requested resource
+var ErrNotFound = errors.New("resource not found")
+
+// ErrMergeConflict is returned when a change cannot be applied because the target moved since
+var ErrMergeConflict = errors.New("merge conflict")
+
+// ErrEmptyRepository is returned when GitHub answers 409 because the repository has no commits yet
+var ErrEmptyRepository = errors.New("git repository is empty")
+
+// statusCode returns the HTTP status code carried by a go-github error, or 0 if there is none
+func statusCode(err error) int {
+
+	var errResponse *github.ErrorResponse
+	if errors.As(err, &errResponse) && errResponse.Response != nil {
+		return errResponse.Response.StatusCode
+	}
+	return 0
+}
+
+// notFound maps a 404 error response to ErrNotFound and returns any other error unchanged
+func notFound(err error) error {
+
+	if statusCode(err) == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return err
+}
+
+// emptyRepository maps a 409 error response to ErrEmptyRepository and returns any other error unchanged
+func emptyRepository(err error) error {
+
+	if statusCode(err) == http.StatusConflict {
+		return ErrEmptyRepository
+	}
+	return err
+}
+
+// ErrMigrationNotReady is returned when the archive of a migration is requested before its export finished
+var ErrMigrationNotReady = errors.New("migration archive not ready")
+
+// ErrTreeTruncated is returned along with the partial result when a tree is too large to be listed at once
+var ErrTreeTruncated = errors.New("tree truncated")
+
+// ErrComparisonTruncated is returned along with the partial figures when a comparison lists too many files
+var ErrComparisonTruncated = errors.New("comparison truncated")
+
+// ErrSearchRateLimited is returned when the search API, limited apart from the other endpoints, refuses a query
+var ErrSearchRateLimited = errors.New("search rate limit exceeded")
+
+// searchRateLimited maps a rate limit error of the search API to ErrSearchRateLimited, telling when it resets,
+// and returns any other error unchanged
+func searchRateLimited(err error) error {
+
+	var rateErr *github.RateLimitError
+	if errors.As(err, &rateErr) {
+		return fmt.Errorf("%w until %s", ErrSearchRateLimited, rateErr.Rate.Reset.Time.Format(time.RFC3339))
+	}
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		return fmt.Errorf("%w, retry after %s", ErrSearchRateLimited, abuseErr.GetRetryAfter())
+	}
+	return err
+}
@@ -6,5 +6,6 @@ require (
 	github.com/dotWicho/utilities v1.0.6
 	github.com/google/go-github/v32 v32.1.0
 	github.com/stretchr/testify v1.6.1
+	golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
 )
@@ -0,0 +1,58 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// graphqlError is an entry of the errors list of a GraphQL response
+type graphqlError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// graphql runs query with variables against the GitHub GraphQL API and decodes its data into v,
+// for the features only available there. GraphQL errors are joined into the returned error
+func (c *Client) graphql(query string, variables map[string]interface{}, v interface{}) error {
+
+	body := struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{Query: query, Variables: variables}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphqlError  `json:"errors"`
+	}
+	if _, err := c.do("POST", c.graphqlURL(), body, &response); err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		if response.Errors[0].Type == "NOT_FOUND" {
+			return fmt.Errorf("graphql: %s: %w", strings.Join(messages, "; "), ErrNotFound)
+		}
+		return fmt.Errorf("graphql: %s", strings.Join(messages, "; "))
+	}
+	return json.Unmarshal(response.Data, v)
+}
+
+// graphqlURL returns the GraphQL endpoint matching the REST base URL, Enterprise serves it at /api/graphql
+func (c *Client) graphqlURL() string {
+
+	if c.enterprise() {
+		return strings.TrimSuffix(c.github.BaseURL.String(), "v3/") + "graphql"
+	}
+	return "graphql"
+}
+
+// enterprise reports whether the client talks to a GitHub Enterprise install, whose REST API lives at /api/v3
+func (c *Client) enterprise() bool {
+
+	return strings.HasSuffix(c.github.BaseURL.String(), "/api/v3/")
+}
@@ -0,0 +1,84 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/url"
+	"strings"
+)
+
+// HookDelivery is a delivery attempt of a repository webhook, go-github v32 does not model them yet.
+// StatusCode is the HTTP status the receiver answered and Redelivery tells a replayed delivery
+type HookDelivery struct {
+	ID          *int64            `json:"id,omitempty"`
+	GUID        *string           `json:"guid,omitempty"`
+	DeliveredAt *github.Timestamp `json:"delivered_at,omitempty"`
+	Redelivery  *bool             `json:"redelivery,omitempty"`
+	Duration    *float64          `json:"duration,omitempty"`
+	Status      *string           `json:"status,omitempty"`
+	StatusCode  *int              `json:"status_code,omitempty"`
+	Event       *string           `json:"event,omitempty"`
+	Action      *string           `json:"action,omitempty"`
+}
+
+// HookDeliveries returns the deliveries of the webhook hookID of repoName, newest first. The listing pages with
+// a cursor, followed under AllPages
+func (c *Client) HookDeliveries(repoName string, hookID int64) ([]*HookDelivery, error) {
+
+	query := url.Values{"per_page": {fmt.Sprint(c.perPage())}}
+
+	var deliveries []*HookDelivery
+	for {
+		var delivery []*HookDelivery
+		u := fmt.Sprintf("repos/%s/%s/hooks/%d/deliveries?%s", c.Organization, repoName, hookID, query.Encode())
+		response, err := c.do("GET", u, nil, &delivery)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		deliveries = append(deliveries, delivery...)
+
+		cursor := nextCursor(response)
+		if len(cursor) == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		query.Set("cursor", cursor)
+	}
+	return deliveries, nil
+}
+
+// RedeliverHook sends again the delivery deliveryID of the webhook hookID of repoName
+func (c *Client) RedeliverHook(repoName string, hookID, deliveryID int64) error {
+
+	u := fmt.Sprintf("repos/%s/%s/hooks/%d/deliveries/%d/attempts", c.Organization, repoName, hookID, deliveryID)
+	_, err := c.do("POST", u, nil, nil)
+
+	// GitHub answers 202 as the redelivery is queued
+	var accepted *github.AcceptedError
+	if errors.As(err, &accepted) {
+		return nil
+	}
+	return notFound(err)
+}
+
+// nextCursor returns the cursor of the next page linked by response, empty on the last page. go-github v32
+// only follows numbered pages
+func nextCursor(response *github.Response) string {
+
+	for _, link := range strings.Split(response.Header.Get("Link"), ",") {
+		segments := strings.Split(strings.TrimSpace(link), ";")
+		if len(segments) < 2 || strings.TrimSpace(segments[1]) != `rel="next"` {
+			continue
+		}
+		next, err := url.Parse(strings.Trim(segments[0], "<>"))
+		if err != nil {
+			return ""
+		}
+		return next.Query().Get("cursor")
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+package git
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestHookDeliveries(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	client.AllPages = true
+
+	mux.HandleFunc("/repos/org/repo/hooks/1/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "v1_2" {
+			_, _ = w.Write([]byte(`[{"id":2,"status_code":200,"redelivery":true}]`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s?per_page=100&cursor=v1_2>; rel="next"`, r.URL.Path))
+		_, _ = w.Write([]byte(`[{"id":3,"status_code":502,"redelivery":false}]`))
+	})
+	redelivered := false
+	mux.HandleFunc("/repos/org/repo/hooks/1/deliveries/3/attempts", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		redelivered = true
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	deliveries, err := client.HookDeliveries("repo", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, deliveries, 2) {
+		assert.Equal(t, 502, *deliveries[0].StatusCode)
+		assert.True(t, *deliveries[1].Redelivery)
+	}
+
+	assert.NoError(t, client.RedeliverHook("repo", 1, *deliveries[0].ID))
+	assert.True(t, redelivered)
+}
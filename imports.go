@@ -0,0 +1,25 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+)
+
+// ImportProgress returns the source import of repoName, its Status field reports the import state
+func (c *Client) ImportProgress(repoName string) (*github.Import, error) {
+
+	imp, _, err := c.github.Migrations.ImportProgress(c.ctx, c.Organization, repoName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return imp, nil
+}
+
+// StartImport begins a source import into repoName described by req
+func (c *Client) StartImport(repoName string, req *github.Import) (*github.Import, error) {
+
+	imp, _, err := c.github.Migrations.StartImport(c.ctx, c.Organization, repoName, req)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return imp, nil
+}
@@ -0,0 +1,161 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"sort"
+)
+
+// CreateIssueFromRequest creates an issue in repoName from req, so labels, assignees and milestone are set at once.
+// The milestone, when given, must exist and the labels are created first when CreateMissingLabels is set
+func (c *Client) CreateIssueFromRequest(repoName string, req *github.IssueRequest) (*github.Issue, error) {
+
+	if req == nil || len(req.GetTitle()) == 0 {
+		return nil, fmt.Errorf("issue title cannot be null nor empty")
+	}
+
+	if req.Milestone != nil {
+		if _, _, err := c.github.Issues.GetMilestone(c.ctx, c.Organization, repoName, req.GetMilestone()); err != nil {
+			if notFound(err) == ErrNotFound {
+				return nil, fmt.Errorf("milestone %d not found in %s: %w", req.GetMilestone(), repoName, ErrNotFound)
+			}
+			return nil, err
+		}
+	}
+
+	if c.CreateMissingLabels && req.Labels != nil {
+		labels := make([]*github.Label, 0, len(*req.Labels))
+		for _, name := range *req.Labels {
+			labels = append(labels, &github.Label{Name: github.String(name)})
+		}
+		if err := c.EnsureLabels(repoName, labels); err != nil {
+			return nil, err
+		}
+	}
+
+	issue, _, err := c.github.Issues.Create(c.ctx, c.Organization, repoName, req)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return issue, nil
+}
+
+// ReopenIssue reopens the closed issue number of repoName
+func (c *Client) ReopenIssue(repoName string, number int) (*github.Issue, error) {
+
+	issue, _, err := c.github.Issues.Edit(c.ctx, c.Organization, repoName, number, &github.IssueRequest{State: github.String("open")})
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return issue, nil
+}
+
+// AssignToLeastLoaded assigns the issue number to the candidate with the fewest open issues assigned across
+// Organization, ties go to the first login in alphabetical order. It returns the updated issue
+func (c *Client) AssignToLeastLoaded(repoName string, number int, candidates []string) (*github.Issue, error) {
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("candidates cannot be null nor empty")
+	}
+
+	sorted := append([]string(nil), candidates...)
+	sort.Strings(sorted)
+
+	chosen, lowest := "", 0
+	for _, login := range sorted {
+		query := fmt.Sprintf("is:open is:issue assignee:%s org:%s", login, c.Organization)
+		result, _, err := c.github.Search.Issues(c.ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
+		if err != nil {
+			return nil, err
+		}
+		if len(chosen) == 0 || result.GetTotal() < lowest {
+			chosen, lowest = login, result.GetTotal()
+		}
+	}
+
+	issue, _, err := c.github.Issues.AddAssignees(c.ctx, c.Organization, repoName, number, []string{chosen})
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return issue, nil
+}
+
+// Issues returns the issues of repoName in state, "open", "closed" or "all", leaving out the pull requests
+// GitHub lists along with them
+func (c *Client) Issues(repoName string, state string) []*github.Issue {
+	//
+	opts := github.IssueListByRepoOptions{State: state, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Issues.ListByRepo(c.ctx, c.Organization, repoName, &pageOpts)
+	})
+	if err != nil {
+		return nil
+	}
+
+	issues := make([]*github.Issue, 0)
+	for _, page := range pages {
+		for _, issue := range page.([]*github.Issue) {
+			if issue.PullRequestLinks == nil {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues
+}
+
+// Issue returns the issue number of repoName
+func (c *Client) Issue(repoName string, number int) *github.Issue {
+
+	if issue, _, err := c.github.Issues.Get(c.ctx, c.Organization, repoName, number); err == nil {
+		return issue
+	}
+	return nil
+}
+
+// CreateIssue creates an issue titled title in repoName with body and labels
+func (c *Client) CreateIssue(repoName, title, body string, labels []string) (*github.Issue, error) {
+
+	req := &github.IssueRequest{Title: github.String(title), Body: github.String(body)}
+	if len(labels) > 0 {
+		req.Labels = &labels
+	}
+	return c.CreateIssueFromRequest(repoName, req)
+}
+
+// CommentOnIssue posts body as a comment on the issue or pull request number of repoName
+func (c *Client) CommentOnIssue(repoName string, number int, body string) (*github.IssueComment, error) {
+
+	if len(body) == 0 {
+		return nil, fmt.Errorf("comment body cannot be null nor empty")
+	}
+
+	comment, _, err := c.github.Issues.CreateComment(c.ctx, c.Organization, repoName, number, &github.IssueComment{Body: github.String(body)})
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return comment, nil
+}
+
+// Comments returns the comments of the issue or pull request number of repoName, oldest first
+func (c *Client) Comments(repoName string, number int) []*github.IssueComment {
+	//
+	opts := github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Issues.ListComments(c.ctx, c.Organization, repoName, number, &pageOpts)
+	})
+	if err != nil {
+		return nil
+	}
+
+	comments := make([]*github.IssueComment, 0)
+	for _, page := range pages {
+		comments = append(comments, page.([]*github.IssueComment)...)
+	}
+	return comments
+}
@@ -0,0 +1,80 @@
+package git
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestIssuesSkipsPullRequests(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		_, _ = w.Write([]byte(`[
+			{"number":1,"title":"bug"},
+			{"number":2,"title":"fix","pull_request":{"url":"https://api.github.com/repos/org/repo/pulls/2"}},
+			{"number":3,"title":"feature"}
+		]`))
+	})
+
+	issues := client.Issues("repo", "open")
+	if assert.Len(t, issues, 2) {
+		assert.Equal(t, 1, issues[0].GetNumber())
+		assert.Equal(t, 3, issues[1].GetNumber())
+	}
+
+	mux.HandleFunc("/repos/org/prs/issues", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"number":2,"pull_request":{"url":"https://api.github.com/repos/org/prs/pulls/2"}}]`))
+	})
+	issues = client.Issues("prs", "all")
+	assert.NotNil(t, issues)
+	assert.Empty(t, issues)
+}
+
+func TestCreateIssue(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		assert.Equal(t, map[string]interface{}{"title": "bug", "body": "steps", "labels": []interface{}{"triage"}}, req)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"number":7,"title":"bug"}`))
+	})
+	mux.HandleFunc("/repos/org/repo/issues/7", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"number":7,"title":"bug"}`))
+	})
+
+	issue, err := client.CreateIssue("repo", "bug", "steps", []string{"triage"})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, issue.GetNumber())
+	assert.Equal(t, "bug", client.Issue("repo", 7).GetTitle())
+	assert.Nil(t, client.Issue("repo", 8))
+}
+
+func TestCommentOnIssueVerbatim(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	body := "## Test results\n\n| suite | result |\n|---|---|\n| unit | :white_check_mark: |\n\n```\nok  \tgithub.com/dotWicho/git\n```\n"
+	mux.HandleFunc("/repos/org/repo/issues/3/comments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[{"id":1,"body":"first"},{"id":2,"body":"second"}]`))
+			return
+		}
+		var comment map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&comment)
+		assert.Equal(t, map[string]interface{}{"body": body}, comment)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": 3, "body": comment["body"]})
+	})
+
+	comment, err := client.CommentOnIssue("repo", 3, body)
+	assert.NoError(t, err)
+	assert.Equal(t, body, comment.GetBody())
+	assert.Len(t, client.Comments("repo", 3), 2)
+}
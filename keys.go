@@ -0,0 +1,47 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+)
+
+// GPGKeys returns the GPG keys of the authenticated user, each one carrying the ID used by DeleteGPGKey
+func (c *Client) GPGKeys() ([]*github.GPGKey, error) {
+	//
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	var keys []*github.GPGKey
+	for {
+		key, response, err := c.github.Users.ListGPGKeys(c.ctx, "", opts)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return keys, nil
+}
+
+// CreateGPGKey adds an ASCII-armored public GPG key to the authenticated user
+func (c *Client) CreateGPGKey(armoredKey string) (*github.GPGKey, error) {
+
+	key, _, err := c.github.Users.CreateGPGKey(c.ctx, armoredKey)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// DeleteGPGKey removes the GPG key identified by id from the authenticated user
+func (c *Client) DeleteGPGKey(id int64) error {
+
+	_, err := c.github.Users.DeleteGPGKey(c.ctx, id)
+	return notFound(err)
+}
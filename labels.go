@@ -0,0 +1,191 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// labelConcurrency bounds how many repositories LabelInventory reads at a time
+const labelConcurrency = 8
+
+// defaultLabels are the labels GitHub seeds every new repository with
+var defaultLabels = []string{
+	"bug",
+	"documentation",
+	"duplicate",
+	"enhancement",
+	"good first issue",
+	"help wanted",
+	"invalid",
+	"question",
+	"wontfix",
+}
+
+// ClearDefaultLabels deletes from repoName the labels GitHub creates by default, matched by exact name.
+// Labels already removed are skipped so it can be run again safely
+func (c *Client) ClearDefaultLabels(repoName string) error {
+
+	labels, err := c.listLabels(repoName)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		existing[label.GetName()] = true
+	}
+
+	for _, name := range defaultLabels {
+		if !existing[name] {
+			continue
+		}
+		if _, err = c.github.Issues.DeleteLabel(c.ctx, c.Organization, repoName, url.PathEscape(name)); err != nil && notFound(err) != ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+// listLabels returns every label defined in repoName, walking all pages
+func (c *Client) listLabels(repoName string) ([]*github.Label, error) {
+	//
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	var labels []*github.Label
+	for {
+		label, response, err := c.github.Issues.ListLabels(c.ctx, c.Organization, repoName, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		labels = append(labels, label...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return labels, nil
+}
+
+// EnsureLabels creates in repoName the labels, matched by name regardless of case, that do not exist yet.
+// Issues created while CreateMissingLabels is set call it first so none of their labels gets dropped
+func (c *Client) EnsureLabels(repoName string, labels []*github.Label) error {
+
+	existing, err := c.listLabels(repoName)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(existing))
+	for _, label := range existing {
+		names[strings.ToLower(label.GetName())] = true
+	}
+
+	for _, label := range labels {
+		if label == nil || names[strings.ToLower(label.GetName())] {
+			continue
+		}
+		if _, _, err = c.github.Issues.CreateLabel(c.ctx, c.Organization, repoName, label); err != nil {
+			return err
+		}
+		names[strings.ToLower(label.GetName())] = true
+	}
+	return nil
+}
+
+// LabelInventory returns, for every label name used in the organization, how many repositories have it.
+// Every repository is walked regardless of AllPages, their labels are read labelConcurrency at a time and
+// the first failure, listing the repositories included, aborts the inventory
+func (c *Client) LabelInventory() (map[string]int, error) {
+
+	inventory := map[string]int{}
+	var firstErr error
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, labelConcurrency)
+
+	repos, reposErr := c.RepositoriesIter("", "")
+	repos(func(repo *github.Repository) bool {
+		mutex.Lock()
+		failed := firstErr != nil
+		mutex.Unlock()
+		if failed {
+			return false
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return false
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(repoName string) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			labels, err := c.listLabels(repoName)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("labels of %s: %w", repoName, err)
+				}
+				return
+			}
+			for _, label := range labels {
+				inventory[label.GetName()]++
+			}
+		}(repo.GetName())
+		return true
+	})
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := reposErr(); err != nil {
+		return nil, fmt.Errorf("repositories of %s: %w", c.Organization, err)
+	}
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return inventory, nil
+}
+
+// Labels returns all labels defined in repoName
+func (c *Client) Labels(repoName string) []*github.Label {
+
+	if labels, err := c.listLabels(repoName); err == nil {
+		return labels
+	}
+	return nil
+}
+
+// AddLabelsToIssue adds labels to the issue or pull request number of repoName, returning all its labels
+func (c *Client) AddLabelsToIssue(repoName string, number int, labels []string) ([]*github.Label, error) {
+
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("labels cannot be null nor empty")
+	}
+
+	added, _, err := c.github.Issues.AddLabelsToIssue(c.ctx, c.Organization, repoName, number, labels)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return added, nil
+}
+
+// RemoveLabelForIssue removes label from the issue or pull request number of repoName
+func (c *Client) RemoveLabelForIssue(repoName string, number int, label string) error {
+
+	_, err := c.github.Issues.RemoveLabelForIssue(c.ctx, c.Organization, repoName, number, url.PathEscape(label))
+	return notFound(err)
+}
@@ -0,0 +1,73 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestIssueLabels(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		var labels []string
+		_ = json.NewDecoder(r.Body).Decode(&labels)
+		assert.Equal(t, []string{"needs review", "area/api"}, labels)
+		_, _ = w.Write([]byte(`[{"name":"needs review"},{"name":"area/api"}]`))
+	})
+	var removed []string
+	mux.HandleFunc("/repos/org/repo/issues/1/labels/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		removed = append(removed, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	labels, err := client.AddLabelsToIssue("repo", 1, []string{"needs review", "area/api"})
+	assert.NoError(t, err)
+	assert.Len(t, labels, 2)
+
+	assert.NoError(t, client.RemoveLabelForIssue("repo", 1, "needs review"))
+	assert.NoError(t, client.RemoveLabelForIssue("repo", 1, "area/api"))
+	assert.Equal(t, []string{
+		"/repos/org/repo/issues/1/labels/needs%20review",
+		"/repos/org/repo/issues/1/labels/area%2Fapi",
+	}, removed)
+}
+
+func TestLabelInventoryWalksEveryRepositoryPage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	failSecondPage := false
+	mux.HandleFunc("/orgs/org/repos", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			if failSecondPage {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprint(w, `[{"name":"b"}]`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next", <%s?page=2>; rel="last"`, r.URL.Path, r.URL.Path))
+		fmt.Fprint(w, `[{"name":"a"}]`)
+	})
+	mux.HandleFunc("/repos/org/a/labels", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"bug"},{"name":"docs"}]`)
+	})
+	mux.HandleFunc("/repos/org/b/labels", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"bug"}]`)
+	})
+
+	inventory, err := client.LabelInventory()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"bug": 2, "docs": 1}, inventory)
+
+	failSecondPage = true
+	inventory, err = client.LabelInventory()
+	assert.Error(t, err)
+	assert.Nil(t, inventory)
+}
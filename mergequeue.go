@@ -0,0 +1,74 @@
+package git
+
+// MergeQueueEntry is a pull request waiting in the merge queue of a branch
+type MergeQueueEntry struct {
+	Number   int
+	Position int
+	State    string
+}
+
+// mergeQueueQuery reads a page of the merge queue entries of a branch
+const mergeQueueQuery = `query($owner: String!, $name: String!, $branch: String!, $after: String) {
+  repository(owner: $owner, name: $name) {
+    mergeQueue(branch: $branch) {
+      entries(first: 100, after: $after) {
+        nodes { position state pullRequest { number } }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}`
+
+// MergeQueueEntries returns the pull requests queued to land on branch in queue order, the merge queue
+// is only exposed by the GraphQL API. ErrNotFound is returned when branch has no merge queue
+func (c *Client) MergeQueueEntries(repoName, branch string) ([]MergeQueueEntry, error) {
+
+	variables := map[string]interface{}{"owner": c.Organization, "name": repoName, "branch": branch}
+
+	entries := make([]MergeQueueEntry, 0)
+	for {
+		var data struct {
+			Repository *struct {
+				MergeQueue *struct {
+					Entries struct {
+						Nodes []struct {
+							Position    int    `json:"position"`
+							State       string `json:"state"`
+							PullRequest *struct {
+								Number int `json:"number"`
+							} `json:"pullRequest"`
+						} `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"entries"`
+				} `json:"mergeQueue"`
+			} `json:"repository"`
+		}
+		if err := c.graphql(mergeQueueQuery, variables, &data); err != nil {
+			return nil, err
+		}
+		if data.Repository == nil || data.Repository.MergeQueue == nil {
+			return nil, ErrNotFound
+		}
+
+		page := data.Repository.MergeQueue.Entries
+		for _, node := range page.Nodes {
+			entry := MergeQueueEntry{Position: node.Position, State: node.State}
+			if node.PullRequest != nil {
+				entry.Number = node.PullRequest.Number
+			}
+			entries = append(entries, entry)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		if err := c.pause(); err != nil {
+			return nil, err
+		}
+		variables["after"] = page.PageInfo.EndCursor
+	}
+	return entries, nil
+}
@@ -0,0 +1,36 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestMergeQueueEntries(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		if body.Variables["branch"] != "main" {
+			fmt.Fprint(w, `{"data":{"repository":{"mergeQueue":null}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"mergeQueue":{"entries":{
+			"nodes":[{"position":1,"state":"MERGEABLE","pullRequest":{"number":42}},{"position":2,"state":"AWAITING_CHECKS","pullRequest":{"number":7}}],
+			"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}}`)
+	})
+
+	entries, err := client.MergeQueueEntries("repo", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, []MergeQueueEntry{{Number: 42, Position: 1, State: "MERGEABLE"}, {Number: 7, Position: 2, State: "AWAITING_CHECKS"}}, entries)
+
+	_, err = client.MergeQueueEntries("repo", "develop")
+	assert.Equal(t, ErrNotFound, err)
+}
@@ -0,0 +1,114 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"io"
+	"net/http"
+	"time"
+)
+
+// snapshotPollAttempts and snapshotPollInterval bound the wait of ArchiveAndSnapshot for the export to finish
+const (
+	snapshotPollAttempts = 180
+	snapshotPollInterval = 10 * time.Second
+)
+
+// StartMigration starts an export of repoNames of the organization, lockRepositories locks them during the export
+func (c *Client) StartMigration(repoNames []string, lockRepositories bool) (*github.Migration, error) {
+
+	if len(repoNames) == 0 {
+		return nil, fmt.Errorf("repoNames cannot be null nor empty")
+	}
+
+	opts := &github.MigrationOptions{LockRepositories: lockRepositories}
+	migration, _, err := c.github.Migrations.StartMigration(c.ctx, c.Organization, repoNames, opts)
+	if err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+// MigrationStatus returns the migration id of the organization, its State tells if the archive is exported
+func (c *Client) MigrationStatus(id int64) (*github.Migration, error) {
+
+	migration, _, err := c.github.Migrations.MigrationStatus(c.ctx, c.Organization, id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return migration, nil
+}
+
+// DownloadMigrationArchive streams the archive of the migration id, it returns ErrMigrationNotReady while the
+// export is still running. The archive URL is presigned storage, it is fetched without the GitHub credentials
+// which the storage rejects. The caller must close the returned reader
+func (c *Client) DownloadMigrationArchive(id int64) (io.ReadCloser, error) {
+
+	migration, err := c.MigrationStatus(id)
+	if err != nil {
+		return nil, err
+	}
+	switch migration.GetState() {
+	case "exported":
+	case "failed":
+		return nil, fmt.Errorf("migration %d failed", id)
+	default:
+		return nil, ErrMigrationNotReady
+	}
+
+	link, err := c.github.Migrations.MigrationArchiveURL(c.ctx, c.Organization, id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	request, err := http.NewRequestWithContext(c.ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the link is presigned for a storage host, which must not receive the token
+	response, err := c.plainClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("archive download of migration %d failed: %s", id, response.Status)
+	}
+	return response.Body, nil
+}
+
+// ArchiveAndSnapshot exports repoName with the migrations API, without locking it, and returns the download URL
+// of the archive once it is ready, a backup to take before any destructive cleanup. The URL is presigned and
+// expires after a short while
+func (c *Client) ArchiveAndSnapshot(repoName string) (archiveURL string, err error) {
+
+	migration, err := c.StartMigration([]string{repoName}, false)
+	if err != nil {
+		if statusCode(err) == http.StatusForbidden {
+			return "", fmt.Errorf("no permission to export %s, an organization owner token is required: %w", repoName, err)
+		}
+		return "", notFound(err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if migration, err = c.MigrationStatus(migration.GetID()); err != nil {
+			return "", err
+		}
+		if state := migration.GetState(); state == "exported" {
+			break
+		} else if state == "failed" {
+			return "", fmt.Errorf("export of %s failed", repoName)
+		}
+		if attempt >= snapshotPollAttempts {
+			return "", fmt.Errorf("export of %s still %s: %w", repoName, migration.GetState(), ErrMigrationNotReady)
+		}
+		if err = c.sleep(snapshotPollInterval); err != nil {
+			return "", err
+		}
+	}
+
+	if archiveURL, err = c.github.Migrations.MigrationArchiveURL(c.ctx, c.Organization, migration.GetID()); err != nil {
+		return "", notFound(err)
+	}
+	return archiveURL, nil
+}
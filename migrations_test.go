@@ -0,0 +1,79 @@
+package git
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestDownloadMigrationArchiveNotReady(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"state":"exporting"}`))
+	})
+
+	archive, err := client.DownloadMigrationArchive(1)
+	assert.Nil(t, archive)
+	assert.Equal(t, ErrMigrationNotReady, err)
+}
+
+func TestArchiveAndSnapshot(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org/migrations", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":2,"state":"pending"}`))
+	})
+	mux.HandleFunc("/orgs/org/migrations/2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":2,"state":"exported"}`))
+	})
+	mux.HandleFunc("/orgs/org/migrations/2/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://storage.example.com/archive.tar.gz?signature=x", http.StatusFound)
+	})
+
+	archiveURL, err := client.ArchiveAndSnapshot("repo")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://storage.example.com/archive.tar.gz?signature=x", archiveURL)
+}
+
+func TestDownloadMigrationArchiveUsesConfiguredTransport(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		fmt.Fprint(w, "archive")
+	}))
+	defer storage.Close()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/orgs/org/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"state":"exported"}`)
+	})
+	mux.HandleFunc("/orgs/org/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storage.URL+"/archive.tar.gz?signature=s", http.StatusFound)
+	})
+
+	var hosts []string
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		hosts = append(hosts, r.URL.Host)
+		return http.DefaultTransport.RoundTrip(r)
+	})
+	client := New("token", WithHTTPClient(&http.Client{Transport: transport}), WithOrganization("org"))
+	client.github.BaseURL, _ = url.Parse(server.URL + "/")
+
+	archive, err := client.DownloadMigrationArchive(1)
+	if assert.NoError(t, err) {
+		content, _ := ioutil.ReadAll(archive)
+		archive.Close()
+		assert.Equal(t, "archive", string(content))
+	}
+	storageURL, _ := url.Parse(storage.URL)
+	assert.Contains(t, hosts, storageURL.Host)
+}
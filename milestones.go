@@ -0,0 +1,45 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+)
+
+// Milestones returns the milestones of repoName in state ("open", "closed" or "all"), with their issue counters
+func (c *Client) Milestones(repoName, state string) []*github.Milestone {
+	//
+	opts := &github.MilestoneListOptions{State: state, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	var milestones []*github.Milestone
+	for {
+		milestone, response, err := c.github.Issues.ListMilestones(c.ctx, c.Organization, repoName, opts)
+		if err != nil {
+			return nil
+		}
+
+		milestones = append(milestones, milestone...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil
+		}
+		opts.Page = response.NextPage
+	}
+	return milestones
+}
+
+// MilestoneProgress returns the percentage of closed issues of the milestone number, 0 when it has no issues
+func (c *Client) MilestoneProgress(repoName string, number int) (percent float64, err error) {
+
+	milestone, _, err := c.github.Issues.GetMilestone(c.ctx, c.Organization, repoName, number)
+	if err != nil {
+		return 0, notFound(err)
+	}
+
+	total := milestone.GetOpenIssues() + milestone.GetClosedIssues()
+	if total == 0 {
+		return 0, nil
+	}
+	return float64(milestone.GetClosedIssues()) * 100 / float64(total), nil
+}
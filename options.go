@@ -0,0 +1,103 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"golang.org/x/oauth2"
+	"net/http"
+	"time"
+)
+
+// Option configures a Client built by New
+type Option func(*Client)
+
+// WithParallelPaging lets the AllPages listings fetch up to n pages at the same time once the first page
+// tells how many there are, listings that do not report their last page keep going one page at a time
+func WithParallelPaging(n int) Option {
+
+	return func(c *Client) {
+		c.parallelPages = n
+	}
+}
+
+// WithContext makes the client issue its requests with ctx instead of context.Background()
+func WithContext(ctx context.Context) Option {
+
+	return func(c *Client) {
+		c.ctx = ctx
+	}
+}
+
+// WithBaseURL points the client to a GitHub Enterprise install at baseURL, like "https://github.example.com/",
+// the api/v3 and api/uploads paths are added when missing
+func WithBaseURL(baseURL string) Option {
+
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sends the requests through httpClient, its transport is wrapped to add the token and the retries
+func WithHTTPClient(httpClient *http.Client) Option {
+
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithOrganization sets the Organization the client works on
+func WithOrganization(org string) Option {
+
+	return func(c *Client) {
+		c.Organization = org
+	}
+}
+
+// WithRetry retries the requests failing with a transient error or a secondary rate limit, making at most
+// maxAttempts attempts with a delay starting at base and doubling. It sets the policy of SetRetryPolicy
+func WithRetry(maxAttempts int, base time.Duration) Option {
+
+	return func(c *Client) {
+		if maxAttempts < 1 {
+			maxAttempts = 1
+		}
+		c.retryPolicy = RetryPolicy{MaxRetries: maxAttempts - 1, Backoff: base}
+	}
+}
+
+// WithPerPage sets the PerPage page size of the listings, GitHub serves at most 100 items per page
+func WithPerPage(n int) Option {
+
+	return func(c *Client) {
+		c.PerPage = n
+	}
+}
+
+// WithTokenSource authenticates the requests with the tokens of source instead of the static token given to New,
+// source is wrapped to reuse a token until it expires
+func WithTokenSource(source oauth2.TokenSource) Option {
+
+	return func(c *Client) {
+		c.tkSource = source
+	}
+}
+
+// WithCache keeps in memory the GET responses carrying an ETag and revalidates them on the next identical request,
+// a 304 is served from the cache without consuming rate limit. CacheStats reports how often it paid off
+func WithCache() Option {
+
+	return func(c *Client) {
+		c.cache = newCacheTransport()
+	}
+}
+
+// failingTransport fails every request with err, it stands for a client whose configuration is invalid
+type failingTransport struct {
+	err error
+}
+
+// RoundTrip implements http.RoundTripper
+func (f failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+
+	return nil, fmt.Errorf("invalid client configuration: %w", f.err)
+}
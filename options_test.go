@@ -0,0 +1,58 @@
+package git
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNewWithoutOptions(t *testing.T) {
+	client := New("token")
+
+	assert.Equal(t, "https://api.github.com/", client.github.BaseURL.String())
+	assert.Equal(t, context.Background(), client.ctx)
+	assert.Empty(t, client.Organization)
+}
+
+func TestNewWithOptions(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"name":"repo"}`))
+	}))
+	defer server.Close()
+
+	proxied := 0
+	httpClient := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		proxied++
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	client := New("token", WithBaseURL(server.URL), WithHTTPClient(httpClient), WithOrganization("org"), WithContext(ctx))
+
+	assert.Equal(t, server.URL+"/api/v3/", client.github.BaseURL.String())
+	assert.Equal(t, "value", client.ctx.Value(key{}))
+	assert.Equal(t, "repo", client.Repository("repo").GetName())
+	assert.Equal(t, []string{"/api/v3/repos/org/repo"}, paths)
+	assert.Equal(t, 1, proxied)
+}
+
+func TestNewWithInvalidBaseURL(t *testing.T) {
+	client := New("token", WithBaseURL("://invalid"), WithOrganization("org"))
+
+	_, err := client.CommitE("repo", "abc")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid client configuration")
+}
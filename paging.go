@@ -0,0 +1,141 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+	"sync"
+)
+
+// maxPerPage is the largest page size GitHub serves, and the default one of the listings
+const maxPerPage = 100
+
+// perPage returns the page size of the listings, PerPage clamped to what GitHub accepts
+func (c *Client) perPage() int {
+
+	if c.PerPage < 1 || c.PerPage > maxPerPage {
+		return maxPerPage
+	}
+	return c.PerPage
+}
+
+// listPages calls fetch for the first page of a listing and, under AllPages, for the following ones, returning
+// the items fetch returned for every page, a slice per page, in listing order. With parallel paging and a known
+// last page the remaining pages are fetched concurrently, otherwise they are followed one by one. fetch must be
+// safe for concurrent use
+func (c *Client) listPages(fetch func(page int) (interface{}, *github.Response, error)) ([]interface{}, error) {
+
+	return c.collectPages(fetch, func(*github.Response) error { return c.pause() })
+}
+
+// listSearchPages is listPages for the search API, following pages one by one it waits for the search rate
+// limit to reset when a page used it up
+func (c *Client) listSearchPages(fetch func(page int) (interface{}, *github.Response, error)) ([]interface{}, error) {
+
+	return c.collectPages(fetch, c.searchPause)
+}
+
+// collectPages gathers the items of the pages walked by pageOrder, pause being called before following a page
+func (c *Client) collectPages(fetch func(page int) (interface{}, *github.Response, error), pause func(*github.Response) error) ([]interface{}, error) {
+
+	var mutex sync.Mutex
+	items := make(map[int]interface{})
+	collect := func(page int) (*github.Response, error) {
+		pageItems, response, err := fetch(page)
+		if err == nil {
+			mutex.Lock()
+			items[page] = pageItems
+			mutex.Unlock()
+		}
+		return response, err
+	}
+
+	order, err := c.pageOrder(collect, pause)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]interface{}, 0, len(order))
+	for _, page := range order {
+		pages = append(pages, items[page])
+	}
+	return pages, nil
+}
+
+// pageOrder calls fetch for the pages listPages walks, returning the page numbers fetched in listing order
+func (c *Client) pageOrder(fetch func(page int) (*github.Response, error), pause func(*github.Response) error) ([]int, error) {
+
+	response, err := fetch(0)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := []int{0}
+	if response.NextPage == 0 || !c.AllPages {
+		return pages, nil
+	}
+
+	if c.parallelPages > 1 && response.LastPage > 0 {
+		for page := response.NextPage; page <= response.LastPage; page++ {
+			pages = append(pages, page)
+		}
+		return pages, c.fetchParallel(pages[1:], fetch)
+	}
+
+	for next := response.NextPage; next != 0; next = response.NextPage {
+		if err = pause(response); err != nil {
+			return nil, err
+		}
+		if response, err = fetch(next); err != nil {
+			return nil, err
+		}
+		pages = append(pages, next)
+	}
+	return pages, nil
+}
+
+// fetchParallel calls fetch for every page, at most parallelPages at a time and keeping PageDelay between
+// two dispatches, stopping at the first failure
+func (c *Client) fetchParallel(pages []int, fetch func(page int) (*github.Response, error)) error {
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	semaphore := make(chan struct{}, c.parallelPages)
+
+	failed := func() error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return firstErr
+	}
+
+	for _, page := range pages {
+		if err := c.pause(); err != nil {
+			wg.Wait()
+			return err
+		}
+		select {
+		case <-c.ctx.Done():
+			wg.Wait()
+			return c.ctx.Err()
+		case semaphore <- struct{}{}:
+		}
+		if err := failed(); err != nil {
+			<-semaphore
+			break
+		}
+
+		wg.Add(1)
+		go func(page int) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			if _, err := fetch(page); err != nil {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mutex.Unlock()
+			}
+		}(page)
+	}
+	wg.Wait()
+	return failed()
+}
@@ -0,0 +1,68 @@
+package git
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// pagedRepositories serves name0 ... name<last> as one repository per page, linking every page to the next and last ones
+func pagedRepositories(last int) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		if page < last {
+			w.Header().Set("Link", fmt.Sprintf(`<%s?page=%d>; rel="next", <%s?page=%d>; rel="last"`, r.URL.Path, page+1, r.URL.Path, last))
+		}
+		fmt.Fprintf(w, `[{"name":"repo%d"}]`, page)
+	}
+}
+
+func TestParallelPaging(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org/repos", pagedRepositories(5))
+
+	WithParallelPaging(3)(client)
+	client.AllPages = true
+
+	var names []string
+	for _, repo := range client.Repositories("all", "") {
+		names = append(names, repo.GetName())
+	}
+	assert.Equal(t, []string{"repo1", "repo2", "repo3", "repo4", "repo5"}, names)
+
+	client.AllPages = false
+	assert.Len(t, client.Repositories("all", ""), 1)
+}
+
+func TestPerPage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var perPage []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		perPage = append(perPage, r.URL.Query().Get("per_page"))
+		_, _ = w.Write([]byte(`[]`))
+	}
+	mux.HandleFunc("/repos/org/repo/branches", record)
+	mux.HandleFunc("/repos/org/repo/tags", record)
+	mux.HandleFunc("/orgs/org/repos", record)
+
+	assert.Equal(t, 100, client.perPage())
+	client.PerPage = 30
+	client.Branches("repo")
+	client.Tags("repo")
+	client.Repositories("all", "")
+	assert.Equal(t, []string{"30", "30", "30"}, perPage)
+
+	assert.Equal(t, 100, New("", WithPerPage(500)).perPage())
+	assert.Equal(t, 100, New("", WithPerPage(0)).perPage())
+	assert.Equal(t, 1, New("", WithPerPage(1)).perPage())
+}
@@ -0,0 +1,97 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"sort"
+	"strings"
+)
+
+// ProtectionDrift compares the protection of branch against desired and returns a readable line per difference,
+// an empty slice means the branch is compliant. Nothing is modified on GitHub
+func (c *Client) ProtectionDrift(repoName, branch string, desired *github.ProtectionRequest) ([]string, error) {
+
+	if desired == nil {
+		return nil, fmt.Errorf("desired protection cannot be nil")
+	}
+
+	current, _, err := c.github.Repositories.GetBranchProtection(c.ctx, c.Organization, repoName, branch)
+	if err != nil {
+		if notFound(err) != ErrNotFound {
+			return nil, err
+		}
+		// an unprotected branch answers 404, compare against an empty protection
+		current = &github.Protection{}
+	}
+	return protectionDrift(current, desired), nil
+}
+
+// protectionDrift returns the differences between current and desired as "<setting>: <current> != <desired>"
+func protectionDrift(current *github.Protection, desired *github.ProtectionRequest) []string {
+
+	drift := make([]string, 0)
+	differ := func(setting string, have, want interface{}) {
+		if fmt.Sprint(have) != fmt.Sprint(want) {
+			drift = append(drift, fmt.Sprintf("%s: %v != %v", setting, have, want))
+		}
+	}
+
+	// Status checks
+	haveChecks, wantChecks := current.RequiredStatusChecks, desired.RequiredStatusChecks
+	differ("required status checks", haveChecks != nil, wantChecks != nil)
+	if haveChecks != nil && wantChecks != nil {
+		differ("strict status checks", haveChecks.Strict, wantChecks.Strict)
+		differ("status check contexts", sortedList(haveChecks.Contexts), sortedList(wantChecks.Contexts))
+	}
+
+	// Pull request reviews
+	haveReviews, wantReviews := current.RequiredPullRequestReviews, desired.RequiredPullRequestReviews
+	differ("required pull request reviews", haveReviews != nil, wantReviews != nil)
+	if haveReviews != nil && wantReviews != nil {
+		differ("required reviews", haveReviews.RequiredApprovingReviewCount, wantReviews.RequiredApprovingReviewCount)
+		differ("dismiss stale reviews", haveReviews.DismissStaleReviews, wantReviews.DismissStaleReviews)
+		differ("require code owner reviews", haveReviews.RequireCodeOwnerReviews, wantReviews.RequireCodeOwnerReviews)
+	}
+
+	// Administrators
+	differ("enforce admins", current.EnforceAdmins != nil && current.EnforceAdmins.Enabled, desired.EnforceAdmins)
+
+	// Push restrictions
+	haveRestrictions, wantRestrictions := current.Restrictions, desired.Restrictions
+	differ("push restrictions", haveRestrictions != nil, wantRestrictions != nil)
+	if haveRestrictions != nil && wantRestrictions != nil {
+		var users, teams, apps []string
+		for _, user := range haveRestrictions.Users {
+			users = append(users, user.GetLogin())
+		}
+		for _, team := range haveRestrictions.Teams {
+			teams = append(teams, team.GetSlug())
+		}
+		for _, app := range haveRestrictions.Apps {
+			apps = append(apps, app.GetSlug())
+		}
+		differ("push restricted users", sortedList(users), sortedList(wantRestrictions.Users))
+		differ("push restricted teams", sortedList(teams), sortedList(wantRestrictions.Teams))
+		differ("push restricted apps", sortedList(apps), sortedList(wantRestrictions.Apps))
+	}
+
+	// Optional toggles, only compared when desired sets them
+	if desired.RequireLinearHistory != nil {
+		differ("require linear history", current.RequireLinearHistory != nil && current.RequireLinearHistory.Enabled, *desired.RequireLinearHistory)
+	}
+	if desired.AllowForcePushes != nil {
+		differ("allow force pushes", current.AllowForcePushes != nil && current.AllowForcePushes.Enabled, *desired.AllowForcePushes)
+	}
+	if desired.AllowDeletions != nil {
+		differ("allow deletions", current.AllowDeletions != nil && current.AllowDeletions.Enabled, *desired.AllowDeletions)
+	}
+	return drift
+}
+
+// sortedList renders values as a sorted, comma separated list enclosed in brackets
+func sortedList(values []string) string {
+
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return "[" + strings.Join(sorted, ", ") + "]"
+}
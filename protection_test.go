@@ -0,0 +1,30 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestProtectionDrift(t *testing.T) {
+	current := &github.Protection{
+		RequiredStatusChecks:       &github.RequiredStatusChecks{Strict: true, Contexts: []string{"lint", "build"}},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{RequiredApprovingReviewCount: 1},
+		EnforceAdmins:              &github.AdminEnforcement{Enabled: true},
+	}
+
+	compliant := &github.ProtectionRequest{
+		RequiredStatusChecks:       &github.RequiredStatusChecks{Strict: true, Contexts: []string{"build", "lint"}},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{RequiredApprovingReviewCount: 1},
+		EnforceAdmins:              true,
+	}
+	assert.Empty(t, protectionDrift(current, compliant))
+
+	drifted := &github.ProtectionRequest{
+		RequiredStatusChecks:       &github.RequiredStatusChecks{Strict: true, Contexts: []string{"build", "lint"}},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{RequiredApprovingReviewCount: 2},
+		EnforceAdmins:              true,
+		RequireLinearHistory:       github.Bool(true),
+	}
+	assert.Equal(t, []string{"required reviews: 1 != 2", "require linear history: false != true"}, protectionDrift(current, drifted))
+}
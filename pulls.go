@@ -0,0 +1,463 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// mergeablePollAttempts and mergeablePollInterval bound the wait for GitHub to compute the mergeability of a
+// pull request, mergeableConcurrency how many pull requests are checked at a time
+const (
+	mergeablePollAttempts = 5
+	mergeablePollInterval = time.Second
+	mergeableConcurrency  = 8
+)
+
+// mergeMethods are the merge methods accepted by MergePullRequest
+var mergeMethods = map[string]bool{"merge": true, "squash": true, "rebase": true}
+
+// MergePullRequest merges the pull request number of repoName using method ("merge", "squash" or "rebase"),
+// squash merges take their commit title from SquashTitleTemplate when it is set
+func (c *Client) MergePullRequest(repoName string, number int, commitMessage string, method string) (*github.PullRequestMergeResult, error) {
+
+	if !mergeMethods[method] {
+		return nil, fmt.Errorf("unknown merge method %q, must be one of merge, squash or rebase", method)
+	}
+
+	opts := &github.PullRequestOptions{MergeMethod: method}
+	if method == "squash" && len(c.SquashTitleTemplate) > 0 {
+		title, err := c.squashTitle(repoName, number)
+		if err != nil {
+			return nil, err
+		}
+		opts.CommitTitle = title
+	}
+
+	result, _, err := c.github.PullRequests.Merge(c.ctx, c.Organization, repoName, number, commitMessage, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// squashTitle renders SquashTitleTemplate with the Number and Title of the pull request number
+func (c *Client) squashTitle(repoName string, number int) (string, error) {
+
+	tmpl, err := template.New("squash").Parse(c.SquashTitleTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid squash title template: %w", err)
+	}
+
+	pr, _, err := c.github.PullRequests.Get(c.ctx, c.Organization, repoName, number)
+	if err != nil {
+		return "", err
+	}
+
+	var title bytes.Buffer
+	fields := struct {
+		Number int
+		Title  string
+	}{Number: pr.GetNumber(), Title: pr.GetTitle()}
+	if err = tmpl.Execute(&title, fields); err != nil {
+		return "", fmt.Errorf("invalid squash title template: %w", err)
+	}
+	return title.String(), nil
+}
+
+// PullRequestForBranch returns the open pull request whose head is branch, a fork owner
+// may be given as prefix ("owner:branch"), otherwise Organization is assumed
+func (c *Client) PullRequestForBranch(repoName, branch string) (*github.PullRequest, error) {
+
+	head := branch
+	if !strings.Contains(branch, ":") {
+		head = fmt.Sprintf("%s:%s", c.Organization, branch)
+	}
+
+	opts := &github.PullRequestListOptions{State: "open", Head: head, ListOptions: github.ListOptions{PerPage: 1}}
+	prs, _, err := c.github.PullRequests.List(c.ctx, c.Organization, repoName, opts)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if len(prs) == 0 {
+		return nil, ErrNotFound
+	}
+	return prs[0], nil
+}
+
+// requestReviewers requests the review of users and teams on the pull request id. Unless force is set the
+// reviewers already pending are skipped, and the pull request is returned untouched when nobody is left
+func (c *Client) requestReviewers(id int, repoName string, users, teams []string, force bool) (*github.PullRequest, error) {
+
+	teams = teamSlugs(teams)
+	if !force {
+		pending, err := c.pendingReviewers(id, repoName)
+		if err != nil {
+			return nil, err
+		}
+
+		requested := make(map[string]bool)
+		for _, user := range pending.Users {
+			requested["user:"+strings.ToLower(user.GetLogin())] = true
+		}
+		for _, team := range pending.Teams {
+			requested["team:"+strings.ToLower(team.GetSlug())] = true
+		}
+		users = missingReviewers(users, "user:", requested)
+		teams = missingReviewers(teams, "team:", requested)
+	}
+
+	if len(users) == 0 && len(teams) == 0 {
+		pr, _, err := c.github.PullRequests.Get(c.ctx, c.Organization, repoName, id)
+		return pr, err
+	}
+
+	rr := github.ReviewersRequest{Reviewers: users, TeamReviewers: teams}
+	pr, _, err := c.github.PullRequests.RequestReviewers(c.ctx, c.Organization, repoName, id, rr)
+	return pr, err
+}
+
+// pendingReviewers returns the users and teams whose review is still requested on the pull request id. Every
+// page is read regardless of AllPages, callers deduplicate reviewers with it
+func (c *Client) pendingReviewers(id int, repoName string) (*github.Reviewers, error) {
+	//
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	pending := &github.Reviewers{}
+	for {
+		reviewers, response, err := c.github.PullRequests.ListReviewers(c.ctx, c.Organization, repoName, id, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		pending.Users = append(pending.Users, reviewers.Users...)
+		pending.Teams = append(pending.Teams, reviewers.Teams...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return pending, nil
+}
+
+// teamSlugs returns teams with the "org/" prefix of the "org/slug" form removed, GitHub expects bare slugs
+func teamSlugs(teams []string) []string {
+
+	var slugs []string
+	for _, team := range teams {
+		slugs = append(slugs, team[strings.LastIndex(team, "/")+1:])
+	}
+	return slugs
+}
+
+// missingReviewers returns the names that are not flagged in requested under kind
+func missingReviewers(names []string, kind string, requested map[string]bool) []string {
+
+	var missing []string
+	for _, name := range names {
+		if !requested[kind+strings.ToLower(name)] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// ClosePullRequest closes the pull request number without merging it, closing an already closed one returns it as is
+func (c *Client) ClosePullRequest(repoName string, number int) (*github.PullRequest, error) {
+
+	return c.ClosePullRequestWithReason(repoName, number, "")
+}
+
+// ClosePullRequestWithReason closes the pull request number without merging it, posting reason as
+// comment first when not empty. Closing an already closed pull request returns it without commenting
+func (c *Client) ClosePullRequestWithReason(repoName string, number int, reason string) (*github.PullRequest, error) {
+
+	pr, _, err := c.github.PullRequests.Get(c.ctx, c.Organization, repoName, number)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if pr.GetState() == "closed" {
+		return pr, nil
+	}
+
+	if len(reason) > 0 {
+		if _, err = c.CommentOnIssue(repoName, number, reason); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, _, err = c.github.PullRequests.Edit(c.ctx, c.Organization, repoName, number, &github.PullRequest{State: github.String("closed")})
+	if err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// ReopenPullRequest reopens the closed pull request number. GitHub refuses it when the head branch
+// has been deleted, in that case the branch has to be recreated first
+func (c *Client) ReopenPullRequest(repoName string, number int) (*github.PullRequest, error) {
+
+	pr, _, err := c.github.PullRequests.Edit(c.ctx, c.Organization, repoName, number, &github.PullRequest{State: github.String("open")})
+	if err != nil {
+		if statusCode(err) == http.StatusUnprocessableEntity {
+			return nil, fmt.Errorf("pull request %d cannot be reopened, its head branch may have been deleted and must be recreated first: %w", number, err)
+		}
+		return nil, notFound(err)
+	}
+	return pr, nil
+}
+
+// reviewRequestEvent is the part of an issue timeline event naming who a review was requested from
+type reviewRequestEvent struct {
+	Event             string       `json:"event"`
+	CreatedAt         time.Time    `json:"created_at"`
+	RequestedReviewer *github.User `json:"requested_reviewer"`
+	RequestedTeam     *github.Team `json:"requested_team"`
+}
+
+// ReviewRequestAges returns, for every reviewer still requested on pull request number of repoName, how long
+// ago the latest review_requested event asked them. Users are keyed by login and teams by "org/slug"
+func (c *Client) ReviewRequestAges(repoName string, number int) (map[string]time.Duration, error) {
+
+	reviewers, err := c.pendingReviewers(number, repoName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	pending := map[string]bool{}
+	for _, user := range reviewers.Users {
+		pending[user.GetLogin()] = true
+	}
+	for _, team := range reviewers.Teams {
+		pending[c.Organization+"/"+team.GetSlug()] = true
+	}
+	if len(pending) == 0 {
+		return map[string]time.Duration{}, nil
+	}
+
+	// a re-request restarts the wait, so the latest event per reviewer wins
+	requested := map[string]time.Time{}
+	for page := 1; page != 0; {
+		var events []*reviewRequestEvent
+		urlStr := fmt.Sprintf("repos/%s/%s/issues/%d/timeline?per_page=%d&page=%d", c.Organization, repoName, number, c.perPage(), page)
+		response, err := c.do(http.MethodGet, urlStr, nil, &events)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		for _, event := range events {
+			if event.Event != "review_requested" {
+				continue
+			}
+			name := event.RequestedReviewer.GetLogin()
+			if event.RequestedTeam != nil {
+				name = c.Organization + "/" + event.RequestedTeam.GetSlug()
+			}
+			if pending[name] && event.CreatedAt.After(requested[name]) {
+				requested[name] = event.CreatedAt
+			}
+		}
+
+		if page = response.NextPage; page != 0 {
+			if err = c.pause(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	now := time.Now()
+	ages := make(map[string]time.Duration, len(requested))
+	for name, at := range requested {
+		ages[name] = now.Sub(at)
+	}
+	return ages, nil
+}
+
+// listPullRequests returns every pull request of repoName in state, walking all pages
+func (c *Client) listPullRequests(repoName, state string) ([]*github.PullRequest, error) {
+	//
+	opts := &github.PullRequestListOptions{State: state, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	var prs []*github.PullRequest
+	for {
+		page, response, err := c.github.PullRequests.List(c.ctx, c.Organization, repoName, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		prs = append(prs, page...)
+
+		if response.NextPage == 0 {
+			return prs, nil
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+}
+
+// MergeablePullRequests returns the open pull requests of repoName GitHub reports as mergeable, in listing order.
+// Pull requests whose mergeability is still being computed are polled a few times and skipped if it never comes
+func (c *Client) MergeablePullRequests(repoName string) ([]*github.PullRequest, error) {
+
+	open, err := c.listPullRequests(repoName, "open")
+	if err != nil {
+		return nil, err
+	}
+
+	mergeable := make([]*github.PullRequest, len(open))
+	var firstErr error
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, mergeableConcurrency)
+
+	for i, pr := range open {
+		select {
+		case <-c.ctx.Done():
+			wg.Wait()
+			return nil, c.ctx.Err()
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i, number int) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			pr, err := c.pollMergeable(repoName, number)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if pr.GetMergeable() {
+				mergeable[i] = pr
+			}
+		}(i, pr.GetNumber())
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	result := make([]*github.PullRequest, 0, len(mergeable))
+	for _, pr := range mergeable {
+		if pr != nil {
+			result = append(result, pr)
+		}
+	}
+	return result, nil
+}
+
+// pollMergeable gets pull request number until GitHub has computed its mergeability, or the attempts run out
+func (c *Client) pollMergeable(repoName string, number int) (*github.PullRequest, error) {
+
+	for attempt := 1; ; attempt++ {
+		pr, _, err := c.github.PullRequests.Get(c.ctx, c.Organization, repoName, number)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		if pr.Mergeable != nil || attempt >= mergeablePollAttempts {
+			return pr, nil
+		}
+		if err = c.sleep(mergeablePollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// EnsurePullRequest creates the pull request from srcBranch into dstBranch of repoName, or returns the open one
+// GitHub refuses to duplicate. created tells which of the two happened
+func (c *Client) EnsurePullRequest(repoName, srcBranch, dstBranch, subject, description string) (*github.PullRequest, bool, error) {
+
+	pr, err := c.CreatePullRequestE(repoName, srcBranch, dstBranch, subject, description)
+	if err == nil {
+		return pr, true, nil
+	}
+	if statusCode(err) != http.StatusUnprocessableEntity {
+		return nil, false, err
+	}
+
+	// the 422 also reports other validation failures, it is only a duplicate when an open one has the same base
+	existing, lookupErr := c.PullRequestForBranch(repoName, srcBranch)
+	if lookupErr != nil || existing.GetBase().GetRef() != dstBranch {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+// UpdatePullRequest edits the pull request number of repoName with the fields set in updates, Title, Body,
+// State, Base.Ref and MaintainerCanModify, leaving the others as they are
+func (c *Client) UpdatePullRequest(repoName string, number int, updates *github.PullRequest) (*github.PullRequest, error) {
+
+	if updates == nil {
+		return nil, fmt.Errorf("updates cannot be null")
+	}
+
+	pr, _, err := c.github.PullRequests.Edit(c.ctx, c.Organization, repoName, number, updates)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return pr, nil
+}
+
+// PullRequests returns the pull requests of repoName in state, "open", "closed" or "all"
+func (c *Client) PullRequests(repoName, state string) []*github.PullRequest {
+	//
+	opts := github.PullRequestListOptions{State: state, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.PullRequests.List(c.ctx, c.Organization, repoName, &pageOpts)
+	})
+	if err != nil {
+		return nil
+	}
+
+	prs := make([]*github.PullRequest, 0)
+	for _, page := range pages {
+		prs = append(prs, page.([]*github.PullRequest)...)
+	}
+	return prs
+}
+
+// PullRequest returns the pull request number of repoName
+func (c *Client) PullRequest(repoName string, number int) *github.PullRequest {
+
+	if pr, _, err := c.github.PullRequests.Get(c.ctx, c.Organization, repoName, number); err == nil {
+		return pr
+	}
+	return nil
+}
+
+// AssignTeamReviewers requests the review of teams, given as slugs or "org/slug", on the pull request id,
+// only those not already requested are asked
+func (c *Client) AssignTeamReviewers(id int, repoName string, teams []string) (*github.PullRequest, error) {
+
+	if len(teams) == 0 {
+		return nil, fmt.Errorf("teams cannot be null nor empty")
+	}
+	return c.requestReviewers(id, repoName, nil, teams, false)
+}
+
+// RequestReviews requests the review of users and teams on the pull request id at once, only those not
+// already requested are asked
+func (c *Client) RequestReviews(id int, repoName string, users, teams []string) (*github.PullRequest, error) {
+
+	if len(users) == 0 && len(teams) == 0 {
+		return nil, fmt.Errorf("users and teams cannot be both null nor empty")
+	}
+	return c.requestReviewers(id, repoName, users, teams, false)
+}
@@ -0,0 +1,277 @@
+package git
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAssignReviewersOnlyRequestsDelta(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var requested github.ReviewersRequest
+	mux.HandleFunc("/repos/org/repo/pulls/7/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"users":[{"login":"Alice"}],"teams":[]}`)
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&requested)
+			fmt.Fprint(w, `{"number":7}`)
+		}
+	})
+
+	pr := client.AssignReviewers(7, "repo", []string{"alice", "bob"})
+	assert.NotNil(t, pr)
+	assert.Equal(t, []string{"bob"}, requested.Reviewers)
+
+	requested = github.ReviewersRequest{}
+	pr = client.RerequestReviewers(7, "repo", []string{"alice", "bob"})
+	assert.NotNil(t, pr)
+	assert.Equal(t, []string{"alice", "bob"}, requested.Reviewers)
+}
+
+func TestReviewRequestAges(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"users":[{"login":"alice"}],"teams":[{"slug":"core"}]}`))
+	})
+	ago := func(d time.Duration) string { return time.Now().Add(-d).UTC().Format(time.RFC3339) }
+	mux.HandleFunc("/repos/org/repo/issues/1/timeline", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `[
+			{"event":"review_requested","created_at":%q,"requested_reviewer":{"login":"alice"}},
+			{"event":"review_requested","created_at":%q,"requested_reviewer":{"login":"bob"}},
+			{"event":"review_requested","created_at":%q,"requested_team":{"slug":"core"}},
+			{"event":"commented","created_at":%q},
+			{"event":"review_requested","created_at":%q,"requested_reviewer":{"login":"alice"}}
+		]`, ago(48*time.Hour), ago(24*time.Hour), ago(5*time.Hour), ago(time.Hour), ago(2*time.Hour))
+	})
+
+	ages, err := client.ReviewRequestAges("repo", 1)
+	assert.NoError(t, err)
+	assert.Len(t, ages, 2)
+	assert.InDelta(t, (2 * time.Hour).Seconds(), ages["alice"].Seconds(), 5)
+	assert.InDelta(t, (5 * time.Hour).Seconds(), ages["org/core"].Seconds(), 5)
+}
+
+func TestCreatePullRequestValidationFailed(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message":"Validation Failed","errors":[{"message":"A pull request already exists for org:feature."}]}`))
+	})
+
+	assert.NotPanics(t, func() {
+		assert.Nil(t, client.CreatePullRequest("repo", "feature", "master", "subject", ""))
+	})
+
+	pr, err := client.CreatePullRequestE("repo", "feature", "master", "subject", "")
+	assert.Nil(t, pr)
+	assert.Equal(t, http.StatusUnprocessableEntity, statusCode(err))
+}
+
+func TestMergeablePullRequests(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		_, _ = w.Write([]byte(`[{"number":1},{"number":2},{"number":3}]`))
+	})
+	mux.HandleFunc("/repos/org/repo/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"number":1,"mergeable":true}`))
+	})
+	mux.HandleFunc("/repos/org/repo/pulls/2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"number":2,"mergeable":false}`))
+	})
+	computed := false
+	mux.HandleFunc("/repos/org/repo/pulls/3", func(w http.ResponseWriter, r *http.Request) {
+		if !computed {
+			computed = true
+			_, _ = w.Write([]byte(`{"number":3,"mergeable":null}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"number":3,"mergeable":true}`))
+	})
+
+	prs, err := client.MergeablePullRequests("repo")
+	assert.NoError(t, err)
+	if assert.Len(t, prs, 2) {
+		assert.Equal(t, 1, prs[0].GetNumber())
+		assert.Equal(t, 3, prs[1].GetNumber())
+	}
+}
+
+func TestMergePullRequestMethods(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var method string
+	mux.HandleFunc("/repos/org/repo/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		var options map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&options)
+		method, _ = options["merge_method"].(string)
+		assert.Equal(t, "Ship it", options["commit_message"])
+		_, _ = w.Write([]byte(`{"sha":"abc","merged":true}`))
+	})
+
+	for _, want := range []string{"merge", "squash", "rebase"} {
+		result, err := client.MergePullRequest("repo", 1, "Ship it", want)
+		assert.NoError(t, err)
+		assert.True(t, result.GetMerged())
+		assert.Equal(t, want, method)
+	}
+
+	_, err := client.MergePullRequest("repo", 1, "Ship it", "fast-forward")
+	assert.Error(t, err)
+}
+
+func TestMergePullRequestAlreadyMerged(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		_, _ = w.Write([]byte(`{"message":"Pull Request is not mergeable"}`))
+	})
+
+	result, err := client.MergePullRequest("repo", 1, "", "merge")
+	assert.Nil(t, result)
+	assert.Equal(t, http.StatusMethodNotAllowed, statusCode(err))
+}
+
+func TestEnsurePullRequest(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	exists := false
+	mux.HandleFunc("/repos/org/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			assert.Equal(t, "org:feature", r.URL.Query().Get("head"))
+			_, _ = w.Write([]byte(`[{"number":4,"base":{"ref":"master"}}]`))
+		case exists:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message":"Validation Failed","errors":[{"message":"A pull request already exists for org:feature."}]}`))
+		default:
+			exists = true
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"number":4,"base":{"ref":"master"}}`))
+		}
+	})
+
+	pr, created, err := client.EnsurePullRequest("repo", "feature", "master", "subject", "")
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, 4, pr.GetNumber())
+
+	pr, created, err = client.EnsurePullRequest("repo", "feature", "master", "subject", "")
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, 4, pr.GetNumber())
+
+	// an open pull request into another base does not make the 422 a duplicate
+	_, _, err = client.EnsurePullRequest("repo", "feature", "develop", "subject", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, statusCode(err))
+}
+
+func TestUpdatePullRequestOnlyBase(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/pulls/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var update map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&update)
+		assert.Equal(t, map[string]interface{}{"base": "develop"}, update)
+		_, _ = w.Write([]byte(`{"number":5,"title":"Keep me","base":{"ref":"develop"}}`))
+	})
+
+	pr, err := client.UpdatePullRequest("repo", 5, &github.PullRequest{Base: &github.PullRequestBranch{Ref: github.String("develop")}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Keep me", pr.GetTitle())
+	assert.Equal(t, "develop", pr.GetBase().GetRef())
+}
+
+func TestRequestReviewsWithTeams(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var requested github.ReviewersRequest
+	mux.HandleFunc("/repos/org/repo/pulls/8/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"users":[],"teams":[{"slug":"docs"}]}`)
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&requested)
+			fmt.Fprint(w, `{"number":8}`)
+		}
+	})
+
+	_, err := client.AssignTeamReviewers(8, "repo", []string{"org/core", "docs"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"core"}, requested.TeamReviewers)
+	assert.Empty(t, requested.Reviewers)
+
+	requested = github.ReviewersRequest{}
+	_, err = client.RequestReviews(8, "repo", []string{"alice"}, []string{"org/backend"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, requested.Reviewers)
+	assert.Equal(t, []string{"backend"}, requested.TeamReviewers)
+}
+
+func TestAssignReviewersESurfacesInvalidReviewer(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/pulls/7/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"users":[],"teams":[]}`)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Reviews may only be requested from collaborators."}`)
+		}
+	})
+
+	pr, err := client.AssignReviewersE(7, "repo", []string{"ghost"})
+	assert.Nil(t, pr)
+	var response *github.ErrorResponse
+	if assert.True(t, errors.As(err, &response)) {
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Response.StatusCode)
+	}
+	assert.Nil(t, client.AssignReviewers(7, "repo", []string{"ghost"}))
+}
+
+func TestAssignReviewersReadsEveryPendingPage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var requested github.ReviewersRequest
+	mux.HandleFunc("/repos/org/repo/pulls/7/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&requested)
+			fmt.Fprint(w, `{"number":7}`)
+		case r.URL.Query().Get("page") == "2":
+			fmt.Fprint(w, `{"users":[{"login":"bob"}],"teams":[]}`)
+		default:
+			w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next", <%s?page=2>; rel="last"`, r.URL.Path, r.URL.Path))
+			fmt.Fprint(w, `{"users":[{"login":"alice"}],"teams":[]}`)
+		}
+	})
+
+	pr, err := client.AssignReviewersE(7, "repo", []string{"alice", "bob", "carol"})
+	assert.NoError(t, err)
+	assert.NotNil(t, pr)
+	assert.Equal(t, []string{"carol"}, requested.Reviewers)
+}
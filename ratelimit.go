@@ -0,0 +1,65 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimits returns the current rate limits of the authenticated user, this call does not count against them
+func (c *Client) RateLimits() (*github.RateLimits, error) {
+
+	limits, _, err := c.github.RateLimits(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// LastRate returns the rate limit reported by the most recent API response of the client and its copies,
+// nil until a response carried one
+func (c *Client) LastRate() *github.Rate {
+
+	return c.rate.last()
+}
+
+// rateTransport records the rate limit headers of every response going through base
+type rateTransport struct {
+	base  http.RoundTripper
+	mutex sync.Mutex
+	rate  *github.Rate
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	response, err := t.base.RoundTrip(req)
+	if err != nil {
+		return response, err
+	}
+
+	limit, errLimit := strconv.Atoi(response.Header.Get("X-RateLimit-Limit"))
+	remaining, errRemaining := strconv.Atoi(response.Header.Get("X-RateLimit-Remaining"))
+	reset, errReset := strconv.ParseInt(response.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if errLimit == nil && errRemaining == nil && errReset == nil {
+		t.mutex.Lock()
+		t.rate = &github.Rate{Limit: limit, Remaining: remaining, Reset: github.Timestamp{Time: time.Unix(reset, 0)}}
+		t.mutex.Unlock()
+	}
+	return response, nil
+}
+
+// last returns a copy of the latest recorded rate, nil when none was recorded
+func (t *rateTransport) last() *github.Rate {
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.rate == nil {
+		return nil
+	}
+	rate := *t.rate
+	return &rate
+}
@@ -0,0 +1,35 @@
+package git
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestLastRate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	remaining := "4999"
+	mux.HandleFunc("/repos/org/repo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", remaining)
+		w.Header().Set("X-RateLimit-Reset", "1600000000")
+		_, _ = w.Write([]byte(`{"name":"repo"}`))
+	})
+
+	assert.Nil(t, client.LastRate())
+
+	assert.NotNil(t, client.Repository("repo"))
+	rate := client.LastRate()
+	if assert.NotNil(t, rate) {
+		assert.Equal(t, 5000, rate.Limit)
+		assert.Equal(t, 4999, rate.Remaining)
+		assert.Equal(t, int64(1600000000), rate.Reset.Unix())
+	}
+
+	// copies share the recorded rate
+	remaining = "4998"
+	assert.NotNil(t, client.WithRetryPolicy(RetryPolicy{}).Repository("repo"))
+	assert.Equal(t, 4998, client.LastRate().Remaining)
+}
@@ -0,0 +1,158 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+)
+
+// ReleaseNotes holds the title and markdown body GitHub generates for a release
+type ReleaseNotes struct {
+	Name string `json:"name"`
+	Body string `json:"body"`
+}
+
+// ReleaseAssets returns the assets uploaded to the release identified by releaseID
+func (c *Client) ReleaseAssets(repoName string, releaseID int64) ([]*github.ReleaseAsset, error) {
+	//
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	var assets []*github.ReleaseAsset
+	for {
+		asset, response, err := c.github.Repositories.ListReleaseAssets(c.ctx, c.Organization, repoName, releaseID, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		assets = append(assets, asset...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return assets, nil
+}
+
+// DeleteReleaseAsset removes the release asset identified by assetID, an already missing asset is not an error
+func (c *Client) DeleteReleaseAsset(repoName string, assetID int64) error {
+
+	_, err := c.github.Repositories.DeleteReleaseAsset(c.ctx, c.Organization, repoName, assetID)
+	if err = notFound(err); err == ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// LatestRelease returns the latest published release of repoName, ErrNotFound when there is none
+func (c *Client) LatestRelease(repoName string) (*github.RepositoryRelease, error) {
+
+	release, _, err := c.github.Repositories.GetLatestRelease(c.ctx, c.Organization, repoName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return release, nil
+}
+
+// LatestSemverTag returns the highest semantic version tag of repoName, tags that are not
+// semantic versions are ignored and ErrNotFound is returned when no tag qualifies
+func (c *Client) LatestSemverTag(repoName string) (string, error) {
+	//
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	var latestTag string
+	var latest semver
+	for {
+		tags, response, err := c.github.Repositories.ListTags(c.ctx, c.Organization, repoName, opts)
+		if err != nil {
+			return "", notFound(err)
+		}
+
+		for _, tag := range tags {
+			if version, ok := parseSemver(tag.GetName()); ok && (len(latestTag) == 0 || latest.less(version)) {
+				latestTag, latest = tag.GetName(), version
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return "", err
+		}
+		opts.Page = response.NextPage
+	}
+
+	if len(latestTag) == 0 {
+		return "", ErrNotFound
+	}
+	return latestTag, nil
+}
+
+// GenerateReleaseNotes asks GitHub to write the notes of tagName since previousTag, when previousTag
+// is empty GitHub picks the previous release by itself. The result fits a RepositoryRelease Name and Body
+func (c *Client) GenerateReleaseNotes(repoName, tagName, previousTag string) (*ReleaseNotes, error) {
+
+	if len(tagName) == 0 {
+		return nil, fmt.Errorf("tagName cannot be null nor empty")
+	}
+
+	body := struct {
+		TagName         string `json:"tag_name"`
+		PreviousTagName string `json:"previous_tag_name,omitempty"`
+	}{TagName: tagName, PreviousTagName: previousTag}
+
+	notes := new(ReleaseNotes)
+	u := fmt.Sprintf("repos/%s/%s/releases/generate-notes", c.Organization, repoName)
+	if _, err := c.do("POST", u, body, notes); err != nil {
+		return nil, notFound(err)
+	}
+	return notes, nil
+}
+
+// Releases returns all releases of repoName, newest first
+func (c *Client) Releases(repoName string) []*github.RepositoryRelease {
+	//
+	opts := github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Repositories.ListReleases(c.ctx, c.Organization, repoName, &pageOpts)
+	})
+	if err != nil {
+		return nil
+	}
+
+	releases := make([]*github.RepositoryRelease, 0)
+	for _, page := range pages {
+		releases = append(releases, page.([]*github.RepositoryRelease)...)
+	}
+	return releases
+}
+
+// ReleaseByTag returns the release of repoName published from tag
+func (c *Client) ReleaseByTag(repoName, tag string) *github.RepositoryRelease {
+
+	if release, _, err := c.github.Repositories.GetReleaseByTag(c.ctx, c.Organization, repoName, tag); err == nil {
+		return release
+	}
+	return nil
+}
+
+// CreateRelease creates the release r in repoName, the tag TagName is created from TargetCommitish when it does
+// not exist yet
+func (c *Client) CreateRelease(repoName string, r *github.RepositoryRelease) (*github.RepositoryRelease, error) {
+
+	if r == nil || len(r.GetTagName()) == 0 {
+		return nil, fmt.Errorf("release tag name cannot be null nor empty")
+	}
+
+	release, _, err := c.github.Repositories.CreateRelease(c.ctx, c.Organization, repoName, r)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return release, nil
+}
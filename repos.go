@@ -0,0 +1,221 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// initPollAttempts and initPollInterval bound the wait for the initial commit of an auto initialized repository
+const (
+	initPollAttempts = 10
+	initPollInterval = 500 * time.Millisecond
+)
+
+// CreateRepositoryInitialized creates repoName with an initial commit holding a README, and optionally
+// a .gitignore and a license from GitHub templates. It returns once the initial commit is visible; when that
+// wait fails the repository exists anyway and is returned along with the error
+func (c *Client) CreateRepositoryInitialized(repoName string, private bool, gitignoreTemplate, licenseTemplate string) (*github.Repository, error) {
+
+	if len(repoName) == 0 {
+		return nil, fmt.Errorf("repoName cannot be null nor empty")
+	}
+
+	newRepo := &github.Repository{Name: github.String(repoName), Private: github.Bool(private), AutoInit: github.Bool(true)}
+	if len(gitignoreTemplate) > 0 {
+		newRepo.GitignoreTemplate = github.String(gitignoreTemplate)
+	}
+	if len(licenseTemplate) > 0 {
+		newRepo.LicenseTemplate = github.String(licenseTemplate)
+	}
+
+	repo, _, err := c.github.Repositories.Create(c.ctx, c.Organization, newRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// auto init is asynchronous, wait until the default branch points to the initial commit
+	for attempt := 0; attempt < initPollAttempts; attempt++ {
+		if _, _, err = c.github.Git.GetRef(c.ctx, c.Organization, repoName, "refs/heads/"+repo.GetDefaultBranch()); err == nil {
+			return repo, nil
+		}
+		if err = c.sleep(initPollInterval); err != nil {
+			return repo, fmt.Errorf("repository %s created but its initial commit is not visible yet: %w", repoName, err)
+		}
+	}
+	return repo, fmt.Errorf("repository %s created but its initial commit is not visible yet", repoName)
+}
+
+// ForkParent returns the repository repoName was forked from, ErrNotFound when it is not a fork
+func (c *Client) ForkParent(repoName string) (*github.Repository, error) {
+
+	repo, err := c.fork(repoName)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetParent(), nil
+}
+
+// ForkSource returns the root of the fork network of repoName, ErrNotFound when it is not a fork
+func (c *Client) ForkSource(repoName string) (*github.Repository, error) {
+
+	repo, err := c.fork(repoName)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetSource(), nil
+}
+
+// DefaultBranch returns the name of the default branch of repoName, a missing repository gives an error
+// wrapping ErrNotFound
+func (c *Client) DefaultBranch(repoName string) (string, error) {
+
+	repo, _, err := c.github.Repositories.Get(c.ctx, c.Organization, repoName)
+	if err != nil {
+		return "", fmt.Errorf("default branch of %s/%s: %w", c.Organization, repoName, notFound(err))
+	}
+	if repo.GetDefaultBranch() == "" {
+		return "", fmt.Errorf("repository %s/%s has no default branch", c.Organization, repoName)
+	}
+	return repo.GetDefaultBranch(), nil
+}
+
+// fork fetches repoName making sure it is a fork carrying its parent and source
+func (c *Client) fork(repoName string) (*github.Repository, error) {
+
+	repo, _, err := c.github.Repositories.Get(c.ctx, c.Organization, repoName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if !repo.GetFork() || repo.Parent == nil || repo.Source == nil {
+		return nil, ErrNotFound
+	}
+	return repo, nil
+}
+
+// RepositoriesPushedSince returns the Organization repositories pushed since, newest first. Repositories are
+// listed by push date so paging stops at the first one pushed before since
+func (c *Client) RepositoriesPushedSince(since time.Time) ([]*github.Repository, error) {
+	//
+	opts := &github.RepositoryListByOrgOptions{Sort: "pushed", Direction: "desc", ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+	repos := make([]*github.Repository, 0)
+	for {
+		repo, response, err := c.github.Repositories.ListByOrg(c.ctx, c.Organization, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		for _, r := range repo {
+			if r.GetPushedAt().Before(since) {
+				return repos, nil
+			}
+			repos = append(repos, r)
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return repos, nil
+}
+
+// SetFeatures turns the issues, wiki, projects and discussions features of repoName on or off, a nil flag leaves
+// its feature untouched. go-github does not know has_discussions so the edit is sent as a plain map
+func (c *Client) SetFeatures(repoName string, hasIssues, hasWiki, hasProjects, hasDiscussions *bool) (*github.Repository, error) {
+
+	if len(repoName) == 0 {
+		return nil, fmt.Errorf("repo cannot be null nor empty")
+	}
+
+	features := map[string]bool{}
+	for name, flag := range map[string]*bool{
+		"has_issues":      hasIssues,
+		"has_wiki":        hasWiki,
+		"has_projects":    hasProjects,
+		"has_discussions": hasDiscussions,
+	} {
+		if flag != nil {
+			features[name] = *flag
+		}
+	}
+	if len(features) == 0 {
+		repository, _, err := c.github.Repositories.Get(c.ctx, c.Organization, repoName)
+		return repository, notFound(err)
+	}
+
+	repository := new(github.Repository)
+	if _, err := c.do(http.MethodPatch, fmt.Sprintf("repos/%s/%s", c.Organization, repoName), features, repository); err != nil {
+		return nil, notFound(err)
+	}
+	return repository, nil
+}
+
+// RepositoriesIter streams the repositories of the organization page by page, fetching a page only once the
+// previous one was consumed, so large organizations are never held in memory. The returned function can be
+// used with range-over-func, it walks every page regardless of AllPages and stops when yield returns false.
+// The second returned function reports the error that ended the iteration early, if any
+func (c *Client) RepositoriesIter(repoType, repoSort string) (func(yield func(*github.Repository) bool), func() error) {
+
+	var err error
+	iter := func(yield func(*github.Repository) bool) {
+		//
+		opts := &github.RepositoryListByOrgOptions{Type: repoType, Sort: repoSort, ListOptions: github.ListOptions{PerPage: c.perPage(), Page: 0}}
+
+		err = nil
+		for {
+			var repos []*github.Repository
+			var response *github.Response
+			if repos, response, err = c.github.Repositories.ListByOrg(c.ctx, c.Organization, opts); err != nil {
+				return
+			}
+			for _, repo := range repos {
+				if !yield(repo) {
+					return
+				}
+			}
+
+			if response.NextPage == 0 {
+				return
+			}
+			if err = c.pause(); err != nil {
+				return
+			}
+			opts.Page = response.NextPage
+		}
+	}
+	return iter, func() error { return err }
+}
+
+// AuthenticatedCloneURL returns the HTTPS clone URL of repoName carrying the client token, for command line git.
+// The URL holds the token in clear, it must never be logged; the errors returned never contain it
+func (c *Client) AuthenticatedCloneURL(repoName string) (string, error) {
+
+	repo, _, err := c.github.Repositories.Get(c.ctx, c.Organization, repoName)
+	if err != nil {
+		return "", notFound(err)
+	}
+
+	cloneURL, err := url.Parse(repo.GetCloneURL())
+	if err != nil || len(cloneURL.Host) == 0 {
+		// without a clone URL the web host is the API one, stripped of its api prefix or path
+		cloneURL = &url.URL{Scheme: c.github.BaseURL.Scheme, Host: strings.TrimPrefix(c.github.BaseURL.Host, "api."), Path: "/" + c.Organization + "/" + repoName + ".git"}
+	}
+
+	token, err := c.tkSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("cannot get a token for cloning %s: %w", repoName, err)
+	}
+	if len(token.AccessToken) == 0 {
+		return "", fmt.Errorf("the client has no token to clone %s with", repoName)
+	}
+	cloneURL.User = url.UserPassword("x-access-token", token.AccessToken)
+	return cloneURL.String(), nil
+}
@@ -0,0 +1,162 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// secondaryRateLimitWait is the delay GitHub asks for after a secondary rate limit without a Retry-After header
+const secondaryRateLimitWait = time.Minute
+
+// RetryPolicy describes how a request failing with a transient error (502, 503 or 504, for idempotent methods
+// only) or hitting a secondary rate limit is retried. MaxRetries is the number of extra attempts, zero disables retries, and Backoff is the
+// first delay, doubled on every further attempt unless GitHub asks for a longer one with a Retry-After header
+type RetryPolicy struct {
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+// retryPolicyKey is the context key of a per call RetryPolicy
+type retryPolicyKey struct{}
+
+// SetRetryPolicy sets the RetryPolicy applied to every call of the client and of its copies, calls already in
+// flight keep the policy they started with
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+
+	c.retry.mutex.Lock()
+	c.retry.policy = policy
+	c.retry.mutex.Unlock()
+}
+
+// WithRetryPolicy returns a shallow copy of the client whose calls use policy instead of the one set with
+// SetRetryPolicy. The policies do not add up: a zero MaxRetries disables retries for those calls only,
+// while the original client keeps its own policy
+func (c *Client) WithRetryPolicy(policy RetryPolicy) *Client {
+
+	return c.WithContext(context.WithValue(c.ctx, retryPolicyKey{}, policy))
+}
+
+// retryTransport retries the requests going through base according to the RetryPolicy of their context,
+// falling back to policy when the context does not carry one
+type retryTransport struct {
+	base   http.RoundTripper
+	mutex  sync.Mutex
+	policy RetryPolicy
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	policy, ok := req.Context().Value(retryPolicyKey{}).(RetryPolicy)
+	if !ok {
+		t.mutex.Lock()
+		policy = t.policy
+		t.mutex.Unlock()
+	}
+
+	// a body that cannot be rewound can only be sent once
+	if req.Body != nil && req.GetBody == nil {
+		policy.MaxRetries = 0
+	}
+
+	delay := policy.Backoff
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		response, err := t.base.RoundTrip(attemptReq)
+		if err != nil || attempt >= policy.MaxRetries {
+			return response, err
+		}
+		retry, minWait := retryable(req.Method, response)
+		if !retry {
+			return response, nil
+		}
+
+		wait := delay
+		if minWait > wait {
+			wait = minWait
+		}
+		// a retry that cannot happen before the deadline gives up with the failed response
+		if deadline, ok := req.Context().Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return response, nil
+		}
+		response.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// retryable reports whether response to a method request is a transient failure worth another attempt, and how
+// long at least to wait before it. A gateway failure may hide a processed write, so only idempotent methods are
+// retried then, while a secondary rate limit, which asks for a Retry-After delay or else a minute, is retried
+// for any method since the request was refused
+func retryable(method string, response *http.Response) (bool, time.Duration) {
+
+	switch response.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(method), retryAfter(response)
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		if after := retryAfter(response); after > 0 {
+			return true, after
+		}
+		if secondaryRateLimit(response) {
+			return true, secondaryRateLimitWait
+		}
+	}
+	return false, 0
+}
+
+// idempotent reports whether a request of method can be repeated without adding effects
+func idempotent(method string) bool {
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// secondaryRateLimit reports whether the body of response tells a secondary rate limit was hit, the body is
+// buffered so it can still be read afterwards
+func secondaryRateLimit(response *http.Response) bool {
+
+	body, err := ioutil.ReadAll(io.LimitReader(response.Body, 64<<10))
+	response.Body.Close()
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	message := strings.ToLower(string(body))
+	return strings.Contains(message, "secondary rate limit") || strings.Contains(message, "abuse detection")
+}
+
+// retryAfter returns the delay requested by the Retry-After header of response, zero when absent
+func retryAfter(response *http.Response) time.Duration {
+
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 0
+}
@@ -0,0 +1,131 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithRetryPolicyOverridesClientPolicy(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/org/repo", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"name":"repo"}`)
+	})
+
+	client.SetRetryPolicy(RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond})
+
+	assert.NotNil(t, client.Repository("repo"))
+	assert.Equal(t, 2, calls)
+
+	assert.Nil(t, client.WithRetryPolicy(RetryPolicy{}).Repository("repo"))
+	assert.Equal(t, 3, calls)
+}
+
+func TestWithRetry(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/org/repo", func(w http.ResponseWriter, r *http.Request) {
+		if calls++; calls <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"name":"repo"}`)
+	})
+
+	retrying := New("", WithRetry(3, time.Millisecond), WithOrganization("org"))
+	retrying.github.BaseURL = client.github.BaseURL
+
+	assert.Equal(t, "repo", retrying.Repository("repo").GetName())
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetrySecondaryRateLimit(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/org/limited", func(w http.ResponseWriter, r *http.Request) {
+		if calls++; calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit."}`)
+			return
+		}
+		fmt.Fprint(w, `{"name":"limited"}`)
+	})
+	mux.HandleFunc("/repos/org/blocked", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit."}`)
+	})
+
+	client.SetRetryPolicy(RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond})
+	assert.Equal(t, "limited", client.Repository("limited").GetName())
+	assert.Equal(t, 2, calls)
+
+	// without Retry-After the minute to wait does not fit the deadline, the rate limit error is returned at once
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err := client.WithContext(ctx).ForkParent("blocked")
+	assert.Equal(t, http.StatusForbidden, statusCode(err))
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestRetryGatewayFailuresOnlyForIdempotentMethods(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	calls := map[string]int{}
+	mux.HandleFunc("/repos/org/repo/issues", func(w http.ResponseWriter, r *http.Request) {
+		calls[r.Method]++
+		if r.Method == http.MethodPost && calls[r.Method] == 2 {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"number":1}`)
+			return
+		}
+		if calls[r.Method] == 1 {
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/repos/org/repo/statuses/c0ffee", func(w http.ResponseWriter, r *http.Request) {
+		if calls["status"]++; calls["status"] == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit."}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"state":"success"}`)
+	})
+
+	client.SetRetryPolicy(RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond})
+
+	// a 504 on a write may hide a created issue, it is not sent again
+	_, err := client.CreateIssue("repo", "title", "body", nil)
+	assert.Equal(t, http.StatusGatewayTimeout, statusCode(err))
+	assert.Equal(t, 1, calls[http.MethodPost])
+
+	assert.NotNil(t, client.Issues("repo", "open"))
+	assert.Equal(t, 2, calls[http.MethodGet])
+
+	// a secondary rate limit refused the write, it is sent again
+	_, err = client.CreateStatus("repo", "c0ffee", &github.RepoStatus{State: github.String("success")})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls["status"])
+}
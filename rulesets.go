@@ -0,0 +1,75 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+)
+
+// Ruleset is a repository ruleset, go-github v32 does not model them yet. Conditions, BypassActors and the rule
+// Parameters are kept raw as their shape depends on the target and the rule type
+type Ruleset struct {
+	ID           *int64            `json:"id,omitempty"`
+	Name         *string           `json:"name,omitempty"`
+	Target       *string           `json:"target,omitempty"`
+	SourceType   *string           `json:"source_type,omitempty"`
+	Source       *string           `json:"source,omitempty"`
+	Enforcement  *string           `json:"enforcement,omitempty"`
+	BypassActors json.RawMessage   `json:"bypass_actors,omitempty"`
+	Conditions   json.RawMessage   `json:"conditions,omitempty"`
+	Rules        []*RulesetRule    `json:"rules,omitempty"`
+	CreatedAt    *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt    *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// RulesetRule is a rule of a Ruleset, like "pull_request" or "required_status_checks"
+type RulesetRule struct {
+	Type       *string         `json:"type,omitempty"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// Rulesets returns the rulesets applying to repoName, with their rules and enforcement level. The listing only
+// summarizes the rulesets, so every ruleset is read on its own
+func (c *Client) Rulesets(repoName string) ([]*Ruleset, error) {
+
+	var summaries []*Ruleset
+	for page := 1; page != 0; {
+		var rulesets []*Ruleset
+		u := fmt.Sprintf("repos/%s/%s/rulesets?per_page=%d&page=%d", c.Organization, repoName, c.perPage(), page)
+		response, err := c.do("GET", u, nil, &rulesets)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		summaries = append(summaries, rulesets...)
+
+		if page = response.NextPage; page != 0 {
+			if err = c.pause(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	rulesets := make([]*Ruleset, 0, len(summaries))
+	for _, summary := range summaries {
+		if summary.ID == nil {
+			continue
+		}
+		ruleset, err := c.Ruleset(repoName, *summary.ID)
+		if err != nil {
+			return nil, err
+		}
+		rulesets = append(rulesets, ruleset)
+	}
+	return rulesets, nil
+}
+
+// Ruleset returns the ruleset id of repoName with its rules
+func (c *Client) Ruleset(repoName string, id int64) (*Ruleset, error) {
+
+	ruleset := new(Ruleset)
+	u := fmt.Sprintf("repos/%s/%s/rulesets/%d", c.Organization, repoName, id)
+	if _, err := c.do("GET", u, nil, ruleset); err != nil {
+		return nil, notFound(err)
+	}
+	return ruleset, nil
+}
@@ -0,0 +1,211 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SearchOption tunes a search made by SearchRepositories
+type SearchOption func(*searchSettings)
+
+// searchSettings holds what the SearchOption given to a search set
+type searchSettings struct {
+	opts   github.SearchOptions
+	global bool
+}
+
+// SearchSort sorts the results by field, like "stars" or "updated", in order "asc" or "desc"
+func SearchSort(field, order string) SearchOption {
+
+	return func(s *searchSettings) {
+		s.opts.Sort = field
+		s.opts.Order = order
+	}
+}
+
+// SearchGlobal searches beyond the client Organization, the query is sent as given
+func SearchGlobal() SearchOption {
+
+	return func(s *searchSettings) {
+		s.global = true
+	}
+}
+
+// PullRequestsAwaitingReview returns the open pull requests of Organization still requiring a review, oldest first.
+// They are built from the search results, so only the fields shared with issues are populated
+func (c *Client) PullRequestsAwaitingReview() ([]*github.PullRequest, error) {
+
+	query := fmt.Sprintf("is:open is:pr review:required org:%s", c.Organization)
+	issues, err := c.searchIssues(query, &github.SearchOptions{Sort: "created", Order: "asc"})
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*github.PullRequest, 0, len(issues))
+	for _, issue := range issues {
+		if issue.IsPullRequest() {
+			prs = append(prs, issueToPullRequest(issue))
+		}
+	}
+	sort.SliceStable(prs, func(i, j int) bool {
+		return prs[i].GetCreatedAt().Before(prs[j].GetCreatedAt())
+	})
+	return prs, nil
+}
+
+// searchIssues returns the issues and pull requests matching query
+func (c *Client) searchIssues(query string, opts *github.SearchOptions) ([]*github.Issue, error) {
+	//
+	opts.ListOptions = github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	pages, err := c.listSearchPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := *opts
+		pageOpts.Page = page
+		return c.github.Search.Issues(c.ctx, query, &pageOpts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []*github.Issue
+	for _, page := range pages {
+		issues = append(issues, page.(*github.IssuesSearchResult).Issues...)
+	}
+	return issues, nil
+}
+
+// issueToPullRequest converts an issue search result to a PullRequest, the base repository
+// only carries the URL, Name and FullName taken from its RepositoryURL
+func issueToPullRequest(issue *github.Issue) *github.PullRequest {
+
+	pr := &github.PullRequest{
+		ID:        issue.ID,
+		Number:    issue.Number,
+		State:     issue.State,
+		Title:     issue.Title,
+		Body:      issue.Body,
+		User:      issue.User,
+		Labels:    issue.Labels,
+		Assignees: issue.Assignees,
+		CreatedAt: issue.CreatedAt,
+		UpdatedAt: issue.UpdatedAt,
+		HTMLURL:   issue.HTMLURL,
+		URL:       issue.PullRequestLinks.URL,
+	}
+
+	if issue.RepositoryURL != nil {
+		repo := &github.Repository{URL: issue.RepositoryURL}
+		if parts := strings.Split(issue.GetRepositoryURL(), "/"); len(parts) >= 2 {
+			owner, name := parts[len(parts)-2], parts[len(parts)-1]
+			repo.Name, repo.FullName = github.String(name), github.String(owner+"/"+name)
+		}
+		pr.Base = &github.PullRequestBranch{Repo: repo}
+	}
+	return pr
+}
+
+// SearchInRepo returns the code of repoName matching query, scoped with the repo qualifier so only query itself
+// has to be given. A refused query because of the search rate limit returns ErrSearchRateLimited
+func (c *Client) SearchInRepo(repoName, query string) (*github.CodeSearchResult, error) {
+
+	if len(repoName) == 0 {
+		return nil, fmt.Errorf("repo cannot be null nor empty")
+	}
+	return c.searchCode(fmt.Sprintf("%s repo:%s/%s", query, c.Organization, repoName), &github.SearchOptions{})
+}
+
+// searchCode returns the code matching query, gathering the pages of results
+func (c *Client) searchCode(query string, opts *github.SearchOptions) (*github.CodeSearchResult, error) {
+	//
+	opts.ListOptions = github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	pages, err := c.listSearchPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := *opts
+		pageOpts.Page = page
+		return c.github.Search.Code(c.ctx, query, &pageOpts)
+	})
+	if err != nil {
+		return nil, searchRateLimited(err)
+	}
+
+	results := &github.CodeSearchResult{}
+	for _, page := range pages {
+		result := page.(*github.CodeSearchResult)
+		results.Total = result.Total
+		results.IncompleteResults = result.IncompleteResults
+		results.CodeResults = append(results.CodeResults, result.CodeResults...)
+	}
+	return results, nil
+}
+
+// SearchRepositories returns the repositories of Organization matching query, scoped with the org qualifier
+// unless the query has its own scope or SearchGlobal is given. It returns nil when the search fails
+func (c *Client) SearchRepositories(query string, opts ...SearchOption) []*github.Repository {
+
+	settings := &searchSettings{}
+	for _, opt := range opts {
+		opt(settings)
+	}
+	if !settings.global {
+		query = c.searchScope(query)
+	}
+	settings.opts.ListOptions = github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	pages, err := c.listSearchPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := settings.opts
+		pageOpts.Page = page
+		return c.github.Search.Repositories(c.ctx, query, &pageOpts)
+	})
+	if err != nil {
+		return nil
+	}
+
+	var repos []*github.Repository
+	for _, page := range pages {
+		repos = append(repos, page.(*github.RepositoriesSearchResult).Repositories...)
+	}
+	return repos
+}
+
+// SearchCode returns the code of Organization matching query, scoped with the org qualifier unless the query
+// has its own scope. Every hit carries its Path and Repository. It returns nil when the search fails
+func (c *Client) SearchCode(query string) []*github.CodeResult {
+
+	result, err := c.searchCode(c.searchScope(query), &github.SearchOptions{})
+	if err != nil {
+		return nil
+	}
+	return result.CodeResults
+}
+
+// searchScope appends the org qualifier of Organization to query, unless it is already scoped by an org, user
+// or repo qualifier
+func (c *Client) searchScope(query string) string {
+
+	for _, term := range strings.Fields(query) {
+		for _, qualifier := range []string{"org:", "user:", "repo:"} {
+			if strings.HasPrefix(term, qualifier) {
+				return query
+			}
+		}
+	}
+	if len(c.Organization) == 0 {
+		return query
+	}
+	return strings.TrimSpace(query + " org:" + c.Organization)
+}
+
+// searchPause waits between two pages of search results, until the search rate limit resets when response
+// used up its last request, it resets every minute
+func (c *Client) searchPause(response *github.Response) error {
+
+	if response.Rate.Limit > 0 && response.Rate.Remaining == 0 {
+		if wait := time.Until(response.Rate.Reset.Time); wait > 0 {
+			return c.sleep(wait)
+		}
+	}
+	return c.pause()
+}
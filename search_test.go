@@ -0,0 +1,79 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSearchInRepo(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "TODO repo:org/repo", r.URL.Query().Get("q"))
+		_, _ = w.Write([]byte(`{"total_count":1,"items":[{"name":"main.go","path":"cmd/main.go"}]}`))
+	})
+
+	result, err := client.SearchInRepo("repo", "TODO")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.GetTotal())
+	assert.Equal(t, "cmd/main.go", result.CodeResults[0].GetPath())
+}
+
+func TestSearchInRepoRateLimited(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "30")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"API rate limit exceeded"}`))
+	})
+
+	_, err := client.SearchInRepo("repo", "TODO")
+	assert.True(t, errors.Is(err, ErrSearchRateLimited))
+}
+
+func TestSearchRepositoriesScopesOrganization(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var queries []string
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("q"))
+		assert.Equal(t, "stars", r.URL.Query().Get("sort"))
+		assert.Equal(t, "desc", r.URL.Query().Get("order"))
+		fmt.Fprint(w, `{"total_count":1,"items":[{"name":"api","full_name":"org/api"}]}`)
+	})
+
+	repos := client.SearchRepositories("topic:go archived:false", SearchSort("stars", "desc"))
+	if assert.Len(t, repos, 1) {
+		assert.Equal(t, "org/api", repos[0].GetFullName())
+	}
+	client.SearchRepositories("user:someone topic:go", SearchSort("stars", "desc"))
+	client.SearchRepositories("topic:go", SearchSort("stars", "desc"), SearchGlobal())
+	assert.Equal(t, []string{"topic:go archived:false org:org", "user:someone topic:go", "topic:go"}, queries)
+}
+
+func TestSearchCode(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "filename:Dockerfile FROM org:org", r.URL.Query().Get("q"))
+		fmt.Fprint(w, `{"total_count":1,"items":[{"name":"Dockerfile","path":"build/Dockerfile","repository":{"full_name":"org/api"}}]}`)
+	})
+
+	results := client.SearchCode("filename:Dockerfile FROM")
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "build/Dockerfile", results[0].GetPath())
+		assert.Equal(t, "org/api", results[0].GetRepository().GetFullName())
+	}
+}
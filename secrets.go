@@ -0,0 +1,112 @@
+package git
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/nacl/box"
+	"net/url"
+	"strconv"
+)
+
+// DependabotSecrets returns the Dependabot secrets of repoName, their values are never returned by GitHub
+func (c *Client) DependabotSecrets(repoName string) ([]*github.Secret, error) {
+
+	query := url.Values{"per_page": {strconv.Itoa(c.perPage())}}
+
+	var secrets []*github.Secret
+	for {
+		page := new(github.Secrets)
+		u := fmt.Sprintf("repos/%s/%s/dependabot/secrets?%s", c.Organization, repoName, query.Encode())
+		response, err := c.do("GET", u, nil, page)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		secrets = append(secrets, page.Secrets...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		query.Set("page", strconv.Itoa(response.NextPage))
+	}
+	return secrets, nil
+}
+
+// CreateOrUpdateDependabotSecret stores plaintext as the Dependabot secret name of repoName, sealed
+// with the repository Dependabot public key as GitHub requires
+func (c *Client) CreateOrUpdateDependabotSecret(repoName, name string, plaintext []byte) error {
+
+	if len(name) == 0 {
+		return fmt.Errorf("secret name cannot be null nor empty")
+	}
+
+	key := new(github.PublicKey)
+	u := fmt.Sprintf("repos/%s/%s/dependabot/secrets/public-key", c.Organization, repoName)
+	if _, err := c.do("GET", u, nil, key); err != nil {
+		return notFound(err)
+	}
+
+	sealed, err := sealSecret(key.GetKey(), plaintext)
+	if err != nil {
+		return err
+	}
+
+	secret := &github.EncryptedSecret{KeyID: key.GetKeyID(), EncryptedValue: sealed}
+	u = fmt.Sprintf("repos/%s/%s/dependabot/secrets/%s", c.Organization, repoName, url.PathEscape(name))
+	_, err = c.do("PUT", u, secret, nil)
+	return notFound(err)
+}
+
+// DeleteDependabotSecret removes the Dependabot secret name of repoName
+func (c *Client) DeleteDependabotSecret(repoName, name string) error {
+
+	u := fmt.Sprintf("repos/%s/%s/dependabot/secrets/%s", c.Organization, repoName, url.PathEscape(name))
+	_, err := c.do("DELETE", u, nil, nil)
+	return notFound(err)
+}
+
+// sealSecret encrypts plaintext for the base64 encoded Curve25519 publicKey as a libsodium sealed box,
+// the format GitHub expects for secret values, and returns it base64 encoded
+func sealSecret(publicKey string, plaintext []byte) (string, error) {
+
+	decoded, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(decoded) != 32 {
+		return "", fmt.Errorf("invalid secrets public key")
+	}
+	var recipient [32]byte
+	copy(recipient[:], decoded)
+
+	ephemeralPublic, ephemeralPrivate, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := sealNonce(ephemeralPublic, &recipient)
+	if err != nil {
+		return "", err
+	}
+
+	sealed := box.Seal(append([]byte(nil), ephemeralPublic[:]...), plaintext, nonce, &recipient, ephemeralPrivate)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// sealNonce derives the sealed box nonce, the BLAKE2b-192 hash of both public keys
+func sealNonce(ephemeralPublic, recipient *[32]byte) (*[24]byte, error) {
+
+	hash, err := blake2b.New(24, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = hash.Write(ephemeralPublic[:])
+	_, _ = hash.Write(recipient[:])
+
+	var nonce [24]byte
+	copy(nonce[:], hash.Sum(nil))
+	return &nonce, nil
+}
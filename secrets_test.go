@@ -0,0 +1,32 @@
+package git
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/nacl/box"
+	"testing"
+)
+
+func TestSealSecret(t *testing.T) {
+	public, private, err := box.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	sealed, err := sealSecret(base64.StdEncoding.EncodeToString(public[:]), []byte("registry-token"))
+	assert.NoError(t, err)
+
+	decoded, err := base64.StdEncoding.DecodeString(sealed)
+	assert.NoError(t, err)
+
+	var ephemeral [32]byte
+	copy(ephemeral[:], decoded[:32])
+	nonce, err := sealNonce(&ephemeral, public)
+	assert.NoError(t, err)
+
+	plaintext, ok := box.Open(nil, decoded[32:], nonce, &ephemeral, private)
+	assert.True(t, ok)
+	assert.Equal(t, "registry-token", string(plaintext))
+
+	_, err = sealSecret("not a key", []byte("x"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SecretScanningAlert is a secret scanning alert of a repository, go-github v32 does not model them yet
+type SecretScanningAlert struct {
+	Number     *int              `json:"number,omitempty"`
+	State      *string           `json:"state,omitempty"`
+	SecretType *string           `json:"secret_type,omitempty"`
+	Resolution *string           `json:"resolution,omitempty"`
+	ResolvedBy *github.User      `json:"resolved_by,omitempty"`
+	ResolvedAt *github.Timestamp `json:"resolved_at,omitempty"`
+	CreatedAt  *github.Timestamp `json:"created_at,omitempty"`
+	URL        *string           `json:"url,omitempty"`
+	HTMLURL    *string           `json:"html_url,omitempty"`
+}
+
+// SecretScanningAlerts returns the secret scanning alerts of repoName in state, "open", "resolved" or empty for
+// both. ErrNotFound is returned when secret scanning is not enabled on the repository
+func (c *Client) SecretScanningAlerts(repoName string, state string) ([]*SecretScanningAlert, error) {
+
+	if state != "" && state != "open" && state != "resolved" {
+		return nil, fmt.Errorf("invalid secret scanning alert state %q, must be open or resolved", state)
+	}
+
+	query := url.Values{"per_page": {strconv.Itoa(c.perPage())}}
+	if len(state) > 0 {
+		query.Set("state", state)
+	}
+
+	var alerts []*SecretScanningAlert
+	for {
+		var alert []*SecretScanningAlert
+		u := fmt.Sprintf("repos/%s/%s/secret-scanning/alerts?%s", c.Organization, repoName, query.Encode())
+		response, err := c.do("GET", u, nil, &alert)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		alerts = append(alerts, alert...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		query.Set("page", strconv.Itoa(response.NextPage))
+	}
+	return alerts, nil
+}
+
+// CodeScanningAlerts returns the code scanning alerts of repoName in state ("open", "closed" or empty for open),
+// each carrying its RuleSeverity and Open flag. ErrNotFound is returned when code scanning is not enabled
+func (c *Client) CodeScanningAlerts(repoName, state string) ([]*github.Alert, error) {
+
+	query := url.Values{"per_page": {strconv.Itoa(c.perPage())}}
+	if len(state) > 0 {
+		query.Set("state", state)
+	}
+
+	var alerts []*github.Alert
+	for {
+		var alert []*github.Alert
+		u := fmt.Sprintf("repos/%s/%s/code-scanning/alerts?%s", c.Organization, repoName, query.Encode())
+		response, err := c.do("GET", u, nil, &alert)
+		if err != nil {
+			if statusCode(err) == http.StatusForbidden {
+				return nil, fmt.Errorf("no permission to read code scanning alerts of %s, the security_events scope is required: %w", repoName, err)
+			}
+			return nil, notFound(err)
+		}
+
+		alerts = append(alerts, alert...)
+
+		if response.NextPage == 0 || !c.AllPages {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		query.Set("page", strconv.Itoa(response.NextPage))
+	}
+	return alerts, nil
+}
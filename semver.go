@@ -0,0 +1,83 @@
+package git
+
+import (
+	"strconv"
+	"strings"
+)
+
+// semver holds the comparable parts of a semantic version tag such as v1.2.3-rc.1+build
+type semver struct {
+	major, minor, patch int
+	prerelease          []string
+}
+
+// parseSemver parses tag as a semantic version, an optional leading "v" is accepted
+func parseSemver(tag string) (semver, bool) {
+
+	version := strings.TrimPrefix(tag, "v")
+	if i := strings.Index(version, "+"); i >= 0 {
+		version = version[:i]
+	}
+
+	var parsed semver
+	if i := strings.Index(version, "-"); i >= 0 {
+		if i == len(version)-1 {
+			return semver{}, false
+		}
+		parsed.prerelease = strings.Split(version[i+1:], ".")
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return semver{}, false
+	}
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 || (len(part) > 1 && part[0] == '0') {
+			return semver{}, false
+		}
+		numbers[i] = number
+	}
+	parsed.major, parsed.minor, parsed.patch = numbers[0], numbers[1], numbers[2]
+	return parsed, true
+}
+
+// less reports whether v has lower precedence than other
+func (v semver) less(other semver) bool {
+
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	if v.minor != other.minor {
+		return v.minor < other.minor
+	}
+	if v.patch != other.patch {
+		return v.patch < other.patch
+	}
+
+	// a release has higher precedence than any of its pre-releases
+	if len(v.prerelease) == 0 || len(other.prerelease) == 0 {
+		return len(v.prerelease) > 0 && len(other.prerelease) == 0
+	}
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		a, b := v.prerelease[i], other.prerelease[i]
+		if a == b {
+			continue
+		}
+		na, errA := strconv.Atoi(a)
+		nb, errB := strconv.Atoi(b)
+		switch {
+		case errA == nil && errB == nil:
+			return na < nb
+		case errA == nil:
+			return true
+		case errB == nil:
+			return false
+		default:
+			return a < b
+		}
+	}
+	return len(v.prerelease) < len(other.prerelease)
+}
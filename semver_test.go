@@ -0,0 +1,27 @@
+package git
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseSemver(t *testing.T) {
+	for _, tag := range []string{"1.2.3", "v1.2.3", "v1.2.3-rc.1", "v1.2.3+build.7"} {
+		_, ok := parseSemver(tag)
+		assert.True(t, ok, tag)
+	}
+	for _, tag := range []string{"latest", "v1.2", "v1.02.3", "v1.2.3-", "release-1.2.3"} {
+		_, ok := parseSemver(tag)
+		assert.False(t, ok, tag)
+	}
+}
+
+func TestSemverLess(t *testing.T) {
+	ordered := []string{"v1.0.0-alpha", "v1.0.0-alpha.1", "v1.0.0-beta", "v1.0.0-beta.2", "v1.0.0-beta.11", "v1.0.0", "v1.2.0", "v1.10.0", "v2.0.0"}
+	for i := 1; i < len(ordered); i++ {
+		lower, _ := parseSemver(ordered[i-1])
+		higher, _ := parseSemver(ordered[i])
+		assert.True(t, lower.less(higher), ordered[i-1]+" < "+ordered[i])
+		assert.False(t, higher.less(lower), ordered[i]+" > "+ordered[i-1])
+	}
+}
@@ -0,0 +1,97 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"sync"
+)
+
+// StatusesByCreator returns the commit statuses of ref grouped by the login of the user or app that set them,
+// an empty map when ref has none
+func (c *Client) StatusesByCreator(repoName, ref string) (map[string][]*github.RepoStatus, error) {
+	//
+	opts := &github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	byCreator := make(map[string][]*github.RepoStatus)
+	for {
+		statuses, response, err := c.github.Repositories.ListStatuses(c.ctx, c.Organization, repoName, ref, opts)
+		if err != nil {
+			return nil, notFound(err)
+		}
+
+		for _, status := range statuses {
+			login := status.GetCreator().GetLogin()
+			byCreator[login] = append(byCreator[login], status)
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+		if err = c.pause(); err != nil {
+			return nil, err
+		}
+		opts.Page = response.NextPage
+	}
+	return byCreator, nil
+}
+
+// CreateStatus sets status on the commit sha of repoName. Every status write of the client, and of its copies,
+// holds a slot of the write semaphore so batches stay under the GitHub abuse detection
+func (c *Client) CreateStatus(repoName, sha string, status *github.RepoStatus) (*github.RepoStatus, error) {
+
+	if status == nil || len(status.GetState()) == 0 {
+		return nil, fmt.Errorf("status state cannot be null nor empty")
+	}
+
+	release, err := c.acquireWrite()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	created, _, err := c.github.Repositories.CreateStatus(c.ctx, c.Organization, repoName, sha, status)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return created, nil
+}
+
+// CreateStatusBatch sets status on every commit of shas in repoName, concurrency at a time, returning the
+// failure of each sha that could not be set; an empty map means every status was created
+func (c *Client) CreateStatusBatch(repoName string, shas []string, status *github.RepoStatus, concurrency int) map[string]error {
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	failures := make(map[string]error)
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for i, sha := range shas {
+		select {
+		case <-c.ctx.Done():
+			wg.Wait()
+			// the shas never dispatched fail with the context error
+			for _, sha := range shas[i:] {
+				failures[sha] = c.ctx.Err()
+			}
+			return failures
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(sha string) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			if _, err := c.CreateStatus(repoName, sha, status); err != nil {
+				mutex.Lock()
+				failures[sha] = err
+				mutex.Unlock()
+			}
+		}(sha)
+	}
+	wg.Wait()
+	return failures
+}
@@ -0,0 +1,37 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCreateStatusBatch(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var mutex sync.Mutex
+	var created []string
+	mux.HandleFunc("/repos/org/repo/statuses/", func(w http.ResponseWriter, r *http.Request) {
+		sha := strings.TrimPrefix(r.URL.Path, "/repos/org/repo/statuses/")
+		if sha == "bad" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message":"No commit found for SHA: bad"}`))
+			return
+		}
+		mutex.Lock()
+		created = append(created, sha)
+		mutex.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"state":"success"}`))
+	})
+
+	status := &github.RepoStatus{State: github.String("success"), Context: github.String("ci/backfill")}
+	failures := client.CreateStatusBatch("repo", []string{"a", "bad", "b", "c"}, status, 2)
+	assert.Len(t, failures, 1)
+	assert.Equal(t, http.StatusUnprocessableEntity, statusCode(failures["bad"]))
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, created)
+}
@@ -0,0 +1,141 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ApplySuggestion commits the suggested change of the review comment commentID to the head branch of its pull
+// request, like the "Commit suggestion" button. GraphQL has no mutation for it, so the suggestion is applied to
+// the file and committed through the contents API. ErrMergeConflict is returned when the commented lines
+// changed since the comment, or the branch moved while committing
+func (c *Client) ApplySuggestion(repoName string, commentID int64) (*github.Commit, error) {
+
+	comment, _, err := c.github.PullRequests.GetComment(c.ctx, c.Organization, repoName, commentID)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	suggestion, ok := parseSuggestion(comment.GetBody())
+	if !ok {
+		return nil, fmt.Errorf("review comment %d has no suggestion", commentID)
+	}
+	if comment.Line == nil || comment.GetSide() == "LEFT" {
+		return nil, fmt.Errorf("suggestion of review comment %d is outdated: %w", commentID, ErrMergeConflict)
+	}
+	number, err := strconv.Atoi(path.Base(comment.GetPullRequestURL()))
+	if err != nil {
+		return nil, fmt.Errorf("review comment %d has no pull request: %w", commentID, err)
+	}
+
+	pr, _, err := c.github.PullRequests.Get(c.ctx, c.Organization, repoName, number)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	owner, headRepo := pr.GetHead().GetRepo().GetOwner().GetLogin(), pr.GetHead().GetRepo().GetName()
+
+	filePath := comment.GetPath()
+	original, _, err := c.fileContent(c.Organization, repoName, filePath, comment.GetCommitID())
+	if err != nil {
+		return nil, err
+	}
+	current, blobSHA, err := c.fileContent(owner, headRepo, filePath, pr.GetHead().GetSHA())
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := comment.GetLine(), comment.GetLine()
+	if comment.StartLine != nil {
+		start = comment.GetStartLine()
+	}
+	if !sameLines(original, current, start, end) {
+		return nil, fmt.Errorf("suggestion of review comment %d no longer applies to %s: %w", commentID, filePath, ErrMergeConflict)
+	}
+	content, err := applySuggestion(current, start, end, suggestion)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := c.fileOptions(pr.GetHead().GetRef(), "Apply suggestion from code review")
+	opts.Content = []byte(content)
+	opts.SHA = github.String(blobSHA)
+	response, _, err := c.github.Repositories.UpdateFile(c.ctx, owner, headRepo, filePath, opts)
+	if err != nil {
+		if statusCode(err) == http.StatusConflict {
+			return nil, fmt.Errorf("%s changed while applying the suggestion: %w", filePath, ErrMergeConflict)
+		}
+		return nil, err
+	}
+	return &response.Commit, nil
+}
+
+// fileContent returns the decoded content and blob SHA of filePath in owner/repoName at ref
+func (c *Client) fileContent(owner, repoName, filePath, ref string) (string, string, error) {
+
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
+	file, _, _, err := c.github.Repositories.GetContents(c.ctx, owner, repoName, filePath, opts)
+	if err != nil {
+		return "", "", notFound(err)
+	}
+	if file == nil {
+		return "", "", fmt.Errorf("%s is not a file", filePath)
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return "", "", err
+	}
+	return content, file.GetSHA(), nil
+}
+
+// parseSuggestion returns the text of the first suggestion block of a review comment body
+func parseSuggestion(body string) (string, bool) {
+
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "```suggestion" {
+			continue
+		}
+		for j := i + 1; j < len(lines); j++ {
+			if strings.TrimSpace(lines[j]) == "```" {
+				return strings.Join(lines[i+1:j], "\n"), true
+			}
+		}
+		return "", false
+	}
+	return "", false
+}
+
+// sameLines reports whether the lines start to end, counted from 1, are present and equal in both contents
+func sameLines(a, b string, start, end int) bool {
+
+	linesA, linesB := strings.Split(a, "\n"), strings.Split(b, "\n")
+	if start < 1 || end < start || end > len(linesA) || end > len(linesB) {
+		return false
+	}
+	for i := start - 1; i < end; i++ {
+		if linesA[i] != linesB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// applySuggestion replaces the lines start to end of content, counted from 1, with suggestion,
+// an empty suggestion removes them
+func applySuggestion(content string, start, end int, suggestion string) (string, error) {
+
+	lines := strings.Split(content, "\n")
+	if start < 1 || end < start || end > len(lines) {
+		return "", fmt.Errorf("lines %d to %d are out of the file", start, end)
+	}
+
+	var replacement []string
+	if len(suggestion) > 0 {
+		replacement = strings.Split(suggestion, "\n")
+	}
+	result := append(append(append([]string{}, lines[:start-1]...), replacement...), lines[end:]...)
+	return strings.Join(result, "\n"), nil
+}
@@ -0,0 +1,40 @@
+package git
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseSuggestion(t *testing.T) {
+	suggestion, ok := parseSuggestion("Nit:\r\n```suggestion\r\n\treturn nil\r\n}\r\n```\r\nthanks")
+	assert.True(t, ok)
+	assert.Equal(t, "\treturn nil\n}", suggestion)
+
+	suggestion, ok = parseSuggestion("```suggestion\n```")
+	assert.True(t, ok)
+	assert.Empty(t, suggestion)
+
+	_, ok = parseSuggestion("```go\nreturn nil\n```")
+	assert.False(t, ok)
+	_, ok = parseSuggestion("```suggestion\nreturn nil")
+	assert.False(t, ok)
+}
+
+func TestApplySuggestion(t *testing.T) {
+	content := "a\nb\nc\nd\n"
+
+	applied, err := applySuggestion(content, 2, 3, "x")
+	assert.NoError(t, err)
+	assert.Equal(t, "a\nx\nd\n", applied)
+
+	applied, err = applySuggestion(content, 4, 4, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "a\nb\nc\n", applied)
+
+	_, err = applySuggestion(content, 3, 9, "x")
+	assert.Error(t, err)
+
+	assert.True(t, sameLines(content, "z\nb\nc\nz\n", 2, 3))
+	assert.False(t, sameLines(content, "a\nB\nc\nd\n", 2, 3))
+	assert.False(t, sameLines(content, "a\n", 2, 3))
+}
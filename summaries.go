@@ -0,0 +1,75 @@
+package git
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RepoSummary aggregates the overview figures of a repository, Err records why any of them could not be fetched
+type RepoSummary struct {
+	Name             string
+	Branches         int
+	LatestTag        string
+	OpenPullRequests int
+	Err              error
+}
+
+// RepositorySummaries fetches a RepoSummary for every repoNames entry, running at most concurrency repositories
+// at a time. Per repository failures are recorded in the summary, the returned error is only the context one
+func (c *Client) RepositorySummaries(repoNames []string, concurrency int) (map[string]*RepoSummary, error) {
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	summaries := make(map[string]*RepoSummary, len(repoNames))
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for _, repoName := range repoNames {
+		select {
+		case <-c.ctx.Done():
+			wg.Wait()
+			return summaries, c.ctx.Err()
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(repoName string) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			summary := c.repositorySummary(repoName)
+
+			mutex.Lock()
+			summaries[repoName] = summary
+			mutex.Unlock()
+		}(repoName)
+	}
+	wg.Wait()
+	return summaries, c.ctx.Err()
+}
+
+// repositorySummary collects the branch count, latest tag and open pull request count of repoName
+func (c *Client) repositorySummary(repoName string) *RepoSummary {
+
+	summary := &RepoSummary{Name: repoName}
+
+	if summary.Branches, summary.Err = c.BranchCount(repoName); summary.Err != nil {
+		return summary
+	}
+	if summary.OpenPullRequests, summary.Err = c.countOpenPullRequests(repoName); summary.Err != nil {
+		return summary
+	}
+	if summary.LatestTag, summary.Err = c.LatestSemverTag(repoName); summary.Err == ErrNotFound {
+		summary.Err = nil
+	}
+	return summary
+}
+
+// countOpenPullRequests returns how many open pull requests repoName has, estimated like BranchCount from the
+// last page of a one item listing instead of fetching them all
+func (c *Client) countOpenPullRequests(repoName string) (int, error) {
+
+	return c.EstimateCount(fmt.Sprintf("repos/%s/%s/pulls?state=open", c.Organization, repoName))
+}
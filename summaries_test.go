@@ -0,0 +1,53 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestRepositorySummaries(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/api/branches", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		w.Header().Set("Link", fmt.Sprintf(`<%s?per_page=1&page=2>; rel="next", <%s?per_page=1&page=2>; rel="last"`, r.URL.Path, r.URL.Path))
+		fmt.Fprint(w, `[{"name":"main"}]`)
+	})
+	mux.HandleFunc("/repos/org/api/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		w.Header().Set("Link", fmt.Sprintf(`<%s?state=open&per_page=1&page=2>; rel="next", <%s?state=open&per_page=1&page=42>; rel="last"`, r.URL.Path, r.URL.Path))
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+	mux.HandleFunc("/repos/org/api/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"v1.2.0"},{"name":"v1.10.0"},{"name":"nightly"}]`)
+	})
+	mux.HandleFunc("/repos/org/gone/branches", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	summaries, err := client.RepositorySummaries([]string{"api", "gone"}, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, summaries, 2) {
+		assert.Equal(t, &RepoSummary{Name: "api", Branches: 2, LatestTag: "v1.10.0", OpenPullRequests: 42}, summaries["api"])
+		assert.Equal(t, "gone", summaries["gone"].Name)
+		assert.Equal(t, ErrNotFound, summaries["gone"].Err)
+	}
+}
+
+func TestRepositorySummariesCancelled(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	summaries, err := client.WithContext(ctx).RepositorySummaries([]string{"api", "web"}, 1)
+	assert.Equal(t, context.Canceled, err)
+	for _, summary := range summaries {
+		assert.Error(t, summary.Err)
+	}
+}
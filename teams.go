@@ -0,0 +1,91 @@
+package git
+
+import (
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"sync"
+)
+
+// teamConcurrency bounds how many teams AllTeamRepositories reads at a time
+const teamConcurrency = 8
+
+// TeamRepositories returns the repositories the team teamSlug of the organization has access to, their
+// Permissions are the ones of the team
+func (c *Client) TeamRepositories(teamSlug string) ([]*github.Repository, error) {
+	//
+	opts := github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Teams.ListTeamReposBySlug(c.ctx, c.Organization, teamSlug, &pageOpts)
+	})
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	var repos []*github.Repository
+	for _, page := range pages {
+		repos = append(repos, page.([]*github.Repository)...)
+	}
+	return repos, nil
+}
+
+// AllTeamRepositories returns the repositories of every team of the organization keyed by team slug, reading
+// teamConcurrency teams at a time. Both listings follow AllPages
+func (c *Client) AllTeamRepositories() (map[string][]*github.Repository, error) {
+	//
+	opts := github.ListOptions{PerPage: c.perPage(), Page: 0}
+
+	pages, err := c.listPages(func(page int) (interface{}, *github.Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		return c.github.Teams.ListTeams(c.ctx, c.Organization, &pageOpts)
+	})
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	var teams []*github.Team
+	for _, page := range pages {
+		teams = append(teams, page.([]*github.Team)...)
+	}
+
+	access := make(map[string][]*github.Repository, len(teams))
+	var firstErr error
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, teamConcurrency)
+
+	for _, team := range teams {
+		select {
+		case <-c.ctx.Done():
+			wg.Wait()
+			return nil, c.ctx.Err()
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(slug string) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			repos, err := c.TeamRepositories(slug)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("repositories of team %s: %w", slug, err)
+				}
+				return
+			}
+			access[slug] = repos
+		}(team.GetSlug())
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return access, nil
+}
@@ -0,0 +1,54 @@
+package git
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestAllTeamRepositories(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org/teams", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":1,"slug":"core"},{"id":2,"slug":"docs"}]`))
+	})
+	mux.HandleFunc("/orgs/org/teams/core/repos", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"api","permissions":{"push":true}},{"name":"web"}]`))
+	})
+	mux.HandleFunc("/orgs/org/teams/docs/repos", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"handbook"}]`))
+	})
+
+	access, err := client.AllTeamRepositories()
+	assert.NoError(t, err)
+	assert.Len(t, access, 2)
+	if assert.Len(t, access["core"], 2) {
+		assert.Equal(t, "api", access["core"][0].GetName())
+		assert.True(t, access["core"][0].GetPermissions()["push"])
+	}
+	if assert.Len(t, access["docs"], 1) {
+		assert.Equal(t, "handbook", access["docs"][0].GetName())
+	}
+}
+
+func TestAllTeamRepositoriesTeamFails(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org/teams", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":1,"slug":"core"},{"id":2,"slug":"gone"}]`))
+	})
+	mux.HandleFunc("/orgs/org/teams/core/repos", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"api"}]`))
+	})
+	mux.HandleFunc("/orgs/org/teams/gone/repos", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	access, err := client.AllTeamRepositories()
+	assert.Nil(t, access)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Contains(t, err.Error(), "team gone")
+}
@@ -0,0 +1,46 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+)
+
+// GitignoreTemplates returns the names of the .gitignore templates CreateRepositoryInitialized accepts
+func (c *Client) GitignoreTemplates() ([]string, error) {
+
+	names, _, err := c.github.Gitignores.List(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// GitignoreTemplate returns the .gitignore template name, its Source holds the file content
+func (c *Client) GitignoreTemplate(name string) (*github.Gitignore, error) {
+
+	template, _, err := c.github.Gitignores.Get(c.ctx, name)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return template, nil
+}
+
+// LicenseTemplates returns the license templates, their Key is what CreateRepositoryInitialized accepts.
+// The listing carries no license text, LicenseTemplate returns it
+func (c *Client) LicenseTemplates() ([]*github.License, error) {
+
+	licenses, _, err := c.github.Licenses.List(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return licenses, nil
+}
+
+// LicenseTemplate returns the license template key, its Body holds the license text
+func (c *Client) LicenseTemplate(key string) (*github.License, error) {
+
+	license, _, err := c.github.Licenses.Get(c.ctx, key)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return license, nil
+}
@@ -0,0 +1,201 @@
+package git
+
+import (
+	"fmt"
+	"github.com/dotWicho/utilities"
+	"github.com/google/go-github/v32/github"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// blobConcurrency bounds the blobs fetched at the same time by the multi file readers
+const blobConcurrency = 8
+
+// Git file modes of the tree entries
+const (
+	modeFile       = "100644"
+	modeExecutable = "100755"
+	modeSymlink    = "120000"
+)
+
+// FilesFromTree reads the paths of repoName at ref walking its recursive tree once and then fetching the
+// matching blobs concurrently. Paths that do not exist in the tree are absent from the returned map
+func (c *Client) FilesFromTree(repoName, ref string, paths []string) (map[string][]byte, error) {
+
+	sha, err := c.ResolveRef(repoName, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, _, err := c.github.Git.GetTree(c.ctx, c.Organization, repoName, sha, true)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	wanted := make(map[string]bool, len(paths))
+	for _, filePath := range paths {
+		wanted[filePath] = true
+	}
+
+	blobs := make(map[string]string)
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" && wanted[entry.GetPath()] {
+			blobs[entry.GetPath()] = entry.GetSHA()
+		}
+	}
+	return c.fetchBlobs(repoName, blobs)
+}
+
+// ExportTree reads every file under dirPath of repoName at ref, an empty dirPath meaning the whole repository,
+// keyed by their path in the repository. A tree too large to be listed at once gives the files GitHub returned
+// altogether with an error wrapping ErrTreeTruncated
+func (c *Client) ExportTree(repoName, dirPath, ref string) (map[string][]byte, error) {
+
+	sha, err := c.ResolveRef(repoName, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, _, err := c.github.Git.GetTree(c.ctx, c.Organization, repoName, sha, true)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	prefix := strings.Trim(dirPath, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	blobs := make(map[string]string)
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" && strings.HasPrefix(entry.GetPath(), prefix) {
+			blobs[entry.GetPath()] = entry.GetSHA()
+		}
+	}
+
+	contents, err := c.fetchBlobs(repoName, blobs)
+	if err != nil {
+		return nil, err
+	}
+	if tree.GetTruncated() {
+		return contents, fmt.Errorf("%w: %s of %s at %s is incomplete", ErrTreeTruncated, dirPath, repoName, ref)
+	}
+	return contents, nil
+}
+
+// fetchBlobs fetches concurrently the raw content of the blobs given as path to SHA, returning path to content.
+// It stops at the first failure or when the client context is done
+func (c *Client) fetchBlobs(repoName string, blobs map[string]string) (map[string][]byte, error) {
+
+	contents := make(map[string][]byte, len(blobs))
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	semaphore := make(chan struct{}, blobConcurrency)
+
+	failed := func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return firstErr != nil
+	}
+
+	for filePath, sha := range blobs {
+		select {
+		case <-c.ctx.Done():
+			wg.Wait()
+			return nil, c.ctx.Err()
+		case semaphore <- struct{}{}:
+		}
+		if failed() {
+			<-semaphore
+			break
+		}
+
+		wg.Add(1)
+		go func(filePath, sha string) {
+			defer func() { <-semaphore; wg.Done() }()
+
+			content, _, err := c.github.Git.GetBlobRaw(c.ctx, c.Organization, repoName, sha)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			contents[filePath] = content
+		}(filePath, sha)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return contents, nil
+}
+
+// TreeFromContent creates on top of reference a tree holding files, given as path to content, as regular files
+func (c *Client) TreeFromContent(repoName string, files map[string][]byte, reference *github.Reference) (*github.Tree, error) {
+
+	paths := make([]string, 0, len(files))
+	for filePath := range files {
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+
+	entries := make([]*github.TreeEntry, 0, len(paths))
+	for _, filePath := range paths {
+		entries = append(entries, &github.TreeEntry{Path: github.String(filePath), Type: github.String("blob"), Content: github.String(string(files[filePath])), Mode: github.String(modeFile)})
+	}
+	return c.createTree(repoName, entries, reference)
+}
+
+// createTree creates the tree of entries on top of the commit reference points to
+func (c *Client) createTree(repoName string, entries []*github.TreeEntry, reference *github.Reference) (*github.Tree, error) {
+
+	if reference == nil || reference.GetObject().GetSHA() == "" {
+		return nil, fmt.Errorf("reference cannot be null nor empty")
+	}
+
+	tree, _, err := c.github.Git.CreateTree(c.ctx, c.Organization, repoName, reference.GetObject().GetSHA(), entries)
+	if err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// localTreeEntry builds the blob entry of fileArg read relative to baseDir, a symlink is committed as such with
+// its target as content and a file executable by anyone keeps the executable mode. It returns nil when unreadable
+func localTreeEntry(baseDir, fileArg string) *github.TreeEntry {
+
+	localPath := filepath.Join(baseDir, fileArg)
+	info, err := os.Lstat(localPath)
+	if err != nil {
+		return nil
+	}
+
+	var content, mode string
+	switch {
+	case info.Mode()&os.ModeSymlink != 0:
+		target, err := os.Readlink(localPath)
+		if err != nil {
+			return nil
+		}
+		content, mode = filepath.ToSlash(target), modeSymlink
+	default:
+		data := utilities.ReadFile(localPath)
+		if data == nil {
+			return nil
+		}
+		content, mode = string(data), modeFile
+		if info.Mode()&0111 != 0 {
+			mode = modeExecutable
+		}
+	}
+	return &github.TreeEntry{Path: github.String(fileArg), Type: github.String("blob"), Content: github.String(content), Mode: github.String(mode)}
+}
@@ -0,0 +1,139 @@
+package git
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestExportTree(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/commits/main", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "c0ffee")
+	})
+	mux.HandleFunc("/repos/org/repo/git/trees/c0ffee", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"c0ffee","truncated":true,"tree":[
+			{"path":"config","type":"tree","sha":"t1"},
+			{"path":"config/app.yaml","type":"blob","sha":"b1"},
+			{"path":"config/env/prod.yaml","type":"blob","sha":"b2"},
+			{"path":"configs.md","type":"blob","sha":"b3"},
+			{"path":"README.md","type":"blob","sha":"b4"}]}`)
+	})
+	mux.HandleFunc("/repos/org/repo/git/blobs/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.Path[len("/repos/org/repo/git/blobs/"):])
+	})
+
+	files, err := client.ExportTree("repo", "config/", "main")
+	assert.True(t, errors.Is(err, ErrTreeTruncated))
+	assert.Equal(t, map[string][]byte{
+		"config/app.yaml":      []byte("b1"),
+		"config/env/prod.yaml": []byte("b2"),
+	}, files)
+}
+
+func TestTreeKeepsExecutableAndSymlinkModes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "tree")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "deploy.sh"), []byte("#!/bin/sh\n"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("readme\n"), 0644))
+	assert.NoError(t, os.Symlink("deploy.sh", filepath.Join(dir, "run")))
+	client.BaseDir = dir
+
+	var request struct {
+		Tree []*github.TreeEntry `json:"tree"`
+	}
+	mux.HandleFunc("/repos/org/repo/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		fmt.Fprint(w, `{"sha":"t1"}`)
+	})
+
+	reference := &github.Reference{Object: &github.GitObject{SHA: github.String("c0ffee")}}
+	tree := client.Tree("repo", "deploy.sh,README.md,run", reference)
+	assert.Equal(t, "t1", tree.GetSHA())
+
+	modes := make(map[string]string)
+	contents := make(map[string]string)
+	for _, entry := range request.Tree {
+		modes[entry.GetPath()] = entry.GetMode()
+		contents[entry.GetPath()] = entry.GetContent()
+	}
+	assert.Equal(t, map[string]string{"deploy.sh": "100755", "README.md": "100644", "run": "120000"}, modes)
+	assert.Equal(t, "deploy.sh", contents["run"])
+	assert.Equal(t, "#!/bin/sh\n", contents["deploy.sh"])
+}
+
+func TestTreeFromContent(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var request struct {
+		BaseTree string              `json:"base_tree"`
+		Tree     []*github.TreeEntry `json:"tree"`
+	}
+	mux.HandleFunc("/repos/org/repo/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		fmt.Fprint(w, `{"sha":"t2"}`)
+	})
+
+	reference := &github.Reference{Object: &github.GitObject{SHA: github.String("c0ffee")}}
+	tree, err := client.TreeFromContent("repo", map[string][]byte{"b.txt": []byte("b"), "a/a.txt": []byte("a")}, reference)
+	assert.NoError(t, err)
+	assert.Equal(t, "t2", tree.GetSHA())
+	assert.Equal(t, "c0ffee", request.BaseTree)
+	if assert.Len(t, request.Tree, 2) {
+		assert.Equal(t, "a/a.txt", request.Tree[0].GetPath())
+		assert.Equal(t, "a", request.Tree[0].GetContent())
+		assert.Equal(t, "100644", request.Tree[1].GetMode())
+	}
+
+	_, err = client.TreeFromContent("repo", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestFilesFromTree(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/org/repo/commits/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "c0ffee")
+	})
+	mux.HandleFunc("/repos/org/repo/git/trees/c0ffee", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("recursive"))
+		fmt.Fprint(w, `{"sha":"c0ffee","tree":[
+			{"path":"deploy","type":"tree","sha":"t1"},
+			{"path":"deploy/k8s/service.yaml","type":"blob","sha":"b1"},
+			{"path":"go.mod","type":"blob","sha":"b2"},
+			{"path":"README.md","type":"blob","sha":"b3"}]}`)
+	})
+	var mutex sync.Mutex
+	var fetched []string
+	mux.HandleFunc("/repos/org/repo/git/blobs/", func(w http.ResponseWriter, r *http.Request) {
+		sha := r.URL.Path[len("/repos/org/repo/git/blobs/"):]
+		mutex.Lock()
+		fetched = append(fetched, sha)
+		mutex.Unlock()
+		fmt.Fprint(w, "content of "+sha)
+	})
+
+	files, err := client.FilesFromTree("repo", "v1.0.0", []string{"deploy/k8s/service.yaml", "go.mod", "missing.txt", "deploy"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"deploy/k8s/service.yaml": []byte("content of b1"),
+		"go.mod":                  []byte("content of b2"),
+	}, files)
+	assert.ElementsMatch(t, []string{"b1", "b2"}, fetched)
+}
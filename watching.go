@@ -0,0 +1,23 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+)
+
+// Subscribe sets the authenticated user subscription on repoName, ignored mutes every notification
+func (c *Client) Subscribe(repoName string, subscribed, ignored bool) (*github.Subscription, error) {
+
+	subscription := &github.Subscription{Subscribed: github.Bool(subscribed), Ignored: github.Bool(ignored)}
+	sub, _, err := c.github.Activity.SetRepositorySubscription(c.ctx, c.Organization, repoName, subscription)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return sub, nil
+}
+
+// Unsubscribe removes the authenticated user subscription on repoName
+func (c *Client) Unsubscribe(repoName string) error {
+
+	_, err := c.github.Activity.DeleteRepositorySubscription(c.ctx, c.Organization, repoName)
+	return notFound(err)
+}
@@ -0,0 +1,20 @@
+package git
+
+import (
+	"github.com/google/go-github/v32/github"
+	"net/http"
+)
+
+// ValidatePayload reads the body of the webhook request r and checks its HMAC signature against secret,
+// returning the payload only when the signature matches
+func ValidatePayload(r *http.Request, secret []byte) ([]byte, error) {
+
+	return github.ValidatePayload(r, secret)
+}
+
+// ParseWebHook decodes payload into the go-github event matching eventType, the X-GitHub-Event header,
+// such as *github.PushEvent or *github.PullRequestEvent
+func ParseWebHook(eventType string, payload []byte) (interface{}, error) {
+
+	return github.ParseWebHook(eventType, payload)
+}